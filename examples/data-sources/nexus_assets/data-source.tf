@@ -0,0 +1,18 @@
+data "nexus_assets" "app" {
+  repository = "maven-releases"
+  group      = "com.example"
+  name       = "app"
+  version    = "1.2.3"
+
+  format_filters = {
+    "maven.extension" = "jar"
+  }
+}
+
+output "app_download_url" {
+  value = data.nexus_assets.app.assets[0].download_url
+}
+
+output "app_sha256" {
+  value = data.nexus_assets.app.assets[0].checksum.sha256
+}
@@ -0,0 +1,3 @@
+data "nexus_blobstore_google" "example" {
+  name = "example"
+}
@@ -0,0 +1,8 @@
+data "nexus_blobstores" "all" {}
+
+check "blobstore_quota" {
+  assert {
+    condition     = alltrue([for blobstore in data.nexus_blobstores.all.items : !blobstore.quota_violation])
+    error_message = join("\n", [for blobstore in data.nexus_blobstores.all.items : blobstore.quota_message if blobstore.quota_violation])
+  }
+}
@@ -0,0 +1,5 @@
+data "nexus_capability_types" "all" {}
+
+output "branding_properties" {
+  value = [for t in data.nexus_capability_types.all.types : t.properties if t.id == "rapture.branding"]
+}
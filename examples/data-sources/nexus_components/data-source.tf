@@ -0,0 +1,9 @@
+data "nexus_components" "app" {
+  repository = "maven-releases"
+  group      = "com.example"
+  name       = "app"
+}
+
+output "app_versions" {
+  value = data.nexus_components.app.components[*].version
+}
@@ -0,0 +1,9 @@
+data "nexus_docker_image" "alpine" {
+  repository = "dockerhub"
+  image      = "library/alpine"
+  tag        = "3.19"
+}
+
+output "alpine_image" {
+  value = "nexus.example.com:8082/library/alpine@${data.nexus_docker_image.alpine.digest}"
+}
@@ -0,0 +1,9 @@
+data "nexus_license" "license" {}
+
+resource "nexus_blobstore_group" "group" {
+  count = data.nexus_license.license.installed ? 1 : 0
+
+  name        = "group"
+  fill_policy = "roundRobin"
+  members     = ["default"]
+}
@@ -0,0 +1,17 @@
+data "nexus_maven_latest_version" "service" {
+  repository  = "maven-releases"
+  group_id    = "com.example"
+  artifact_id = "service"
+}
+
+data "nexus_maven_latest_version" "service_snapshot_sources" {
+  repository     = "maven-snapshots"
+  group_id       = "com.example"
+  artifact_id    = "service"
+  version_policy = "SNAPSHOT"
+  classifier     = "sources"
+}
+
+output "service_download_url" {
+  value = data.nexus_maven_latest_version.service.download_url
+}
@@ -0,0 +1,10 @@
+data "nexus_raw_file" "ca_bundle" {
+  repository = "raw-config"
+  path       = "certs/ca-bundle.pem"
+  max_size   = 65536
+}
+
+resource "local_file" "ca_bundle" {
+  filename = "${path.module}/ca-bundle.pem"
+  content  = data.nexus_raw_file.ca_bundle.content
+}
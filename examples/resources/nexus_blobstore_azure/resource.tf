@@ -11,7 +11,19 @@ resource "nexus_blobstore_azure" "example" {
   }
 
   soft_quota {
-    limit = 1024
-    type  = "spaceRemainingQuota"
+    limit = 1024000000
+    type  = "spaceUsedQuota"
+  }
+}
+
+resource "nexus_blobstore_azure" "managed_identity" {
+  name = "managed-identity"
+
+  bucket_configuration {
+    account_name = "example-account-name"
+    authentication {
+      authentication_method = "MANAGEDIDENTITY"
+    }
+    container_name = "example-container-name"
   }
 }
@@ -0,0 +1,2 @@
+# import using the name of blobstore
+terraform import nexus_blobstore_google.example example
@@ -0,0 +1,21 @@
+resource "nexus_blobstore_google" "example" {
+  name = "example"
+
+  bucket_configuration {
+    bucket {
+      name   = "example-bucket"
+      prefix = "nexus"
+      region = "europe-west1"
+    }
+
+    bucket_security {
+      authentication_method = "accountKey"
+      account_key           = file("${path.module}/service-account.json")
+    }
+  }
+
+  soft_quota {
+    limit = 1024000000
+    type  = "spaceUsedQuota"
+  }
+}
@@ -6,3 +6,35 @@ resource "nexus_blobstore_group" "example" {
     nexus_blobstore_file.two.name
   ]
 }
+
+# Convert the existing blobstore "legacy" into a group. The group takes over
+# the name "legacy" and the blobstore is renamed to "legacy-member", so the
+# repositories using it are not changed.
+resource "nexus_blobstore_group" "converted" {
+  name                     = "legacy"
+  converted_blobstore_name = "legacy-member"
+  fill_policy              = "writeToFirst"
+  members = [
+    "legacy-member",
+    nexus_blobstore_file.two.name
+  ]
+}
+
+# The resource which managed the blobstore before can no longer manage it.
+# Replace its block by a removed block (terraform 1.7 or later) in the same
+# apply, or run `terraform state rm nexus_blobstore_file.legacy` afterwards.
+removed {
+  from = nexus_blobstore_file.legacy
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+# After the conversion was applied, the renamed blobstore can be managed
+# again by importing it, e.g. with an import block:
+#
+# import {
+#   to = nexus_blobstore_file.legacy_member
+#   id = "legacy-member"
+# }
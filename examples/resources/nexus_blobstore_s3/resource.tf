@@ -14,7 +14,29 @@ resource "nexus_blobstore_s3" "aws" {
   }
 
   soft_quota {
-    limit = 1024
-    type  = "spaceRemainingQuota"
+    limit = 1024000000
+    type  = "spaceUsedQuota"
+  }
+}
+
+# PRO Feature: replicate blobs to a bucket in another region
+resource "nexus_blobstore_s3" "aws_failover" {
+  name = "blobstore-s3-failover"
+
+  bucket_configuration {
+    bucket {
+      name       = "aws-bucket-name"
+      region     = "eu-central-1"
+      expiration = 3
+    }
+
+    advanced_bucket_connection {
+      max_connection_pool_size = 50
+    }
+
+    failover_buckets {
+      region      = "eu-west-1"
+      bucket_name = "aws-bucket-name-failover"
+    }
   }
 }
@@ -0,0 +1,18 @@
+resource "nexus_blobstore_file" "example" {
+  name = "example"
+  path = "/nexus-data/example"
+}
+
+# change the trigger to compact the blobstore again, e.g. after a large cleanup
+resource "nexus_blobstore_task" "compact" {
+  blobstore = nexus_blobstore_file.example.name
+  task      = "COMPACT"
+
+  triggers = {
+    cleanup = "2024-06-01"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,2 @@
+# import using the id of the capability
+terraform import nexus_capability.branding 3f9a1c2b7e4d5a60
@@ -0,0 +1,9 @@
+resource "nexus_capability" "branding" {
+  type  = "rapture.branding"
+  notes = "Managed by terraform"
+
+  properties = {
+    headerEnabled = "true"
+    headerHtml    = "<div>Staging</div>"
+  }
+}
@@ -0,0 +1,2 @@
+# import using the capability type id
+terraform import nexus_capability_audit.audit audit
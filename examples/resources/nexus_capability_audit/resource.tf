@@ -0,0 +1,4 @@
+resource "nexus_capability_audit" "audit" {
+  enabled = true
+  notes   = "Managed by terraform"
+}
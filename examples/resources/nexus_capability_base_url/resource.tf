@@ -0,0 +1,3 @@
+resource "nexus_capability_base_url" "base_url" {
+  url = "https://nexus.example.com"
+}
@@ -0,0 +1,5 @@
+resource "nexus_capability_ui_settings" "ui" {
+  title           = "Nexus Repository Manager"
+  session_timeout = 60
+  debug_allowed   = false
+}
@@ -0,0 +1,38 @@
+resource "nexus_component" "script" {
+  repository = "raw-internal"
+  format     = "raw"
+
+  raw {
+    directory = "scripts"
+  }
+
+  asset {
+    source   = "${path.module}/files/bootstrap.sh"
+    filename = "bootstrap.sh"
+  }
+}
+
+resource "nexus_component" "parent_pom" {
+  repository = "maven-releases"
+  format     = "maven2"
+
+  maven2 {
+    group_id    = "com.example"
+    artifact_id = "parent"
+    version     = "1.0.0"
+  }
+
+  asset {
+    source    = "${path.module}/files/parent-1.0.0.pom"
+    extension = "pom"
+  }
+}
+
+resource "nexus_component" "package" {
+  repository = "npm-internal"
+  format     = "npm"
+
+  asset {
+    source = "${path.module}/files/package-1.0.0.tgz"
+  }
+}
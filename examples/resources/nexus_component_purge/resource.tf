@@ -0,0 +1,12 @@
+# Delete all snapshots of a retired project
+resource "nexus_component_purge" "legacy_snapshots" {
+  repository = "maven-snapshots"
+  group      = "com.example.legacy"
+  version    = "*-SNAPSHOT"
+
+  max_deletions = 500
+
+  triggers = {
+    decommissioned = "2024-06-30"
+  }
+}
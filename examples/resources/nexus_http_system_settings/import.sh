@@ -0,0 +1,2 @@
+# import the nexus http system settings
+terraform import nexus_http_system_settings.http http
@@ -0,0 +1,26 @@
+resource "nexus_http_system_settings" "http" {
+  user_agent_suffix = "terraform"
+  timeout           = 30
+  retries           = 3
+
+  http_proxy {
+    host = "proxy.example.com"
+    port = 3128
+
+    authentication {
+      type     = "username"
+      username = "proxy-user"
+      password = "changeme"
+    }
+  }
+
+  https_proxy {
+    host = "proxy.example.com"
+    port = 3128
+  }
+
+  non_proxy_hosts = [
+    "*.example.com",
+    "localhost",
+  ]
+}
@@ -0,0 +1,2 @@
+# import the IQ server connection
+terraform import nexus_iq_server.iq iq
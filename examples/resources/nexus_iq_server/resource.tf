@@ -0,0 +1,6 @@
+resource "nexus_iq_server" "iq" {
+  url       = "https://iq.example.com"
+  username  = "nexus"
+  password  = var.iq_password
+  show_link = true
+}
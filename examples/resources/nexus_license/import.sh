@@ -0,0 +1,2 @@
+# import the installed license, license_base64 is not imported
+terraform import nexus_license.license license
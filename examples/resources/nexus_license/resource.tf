@@ -0,0 +1,3 @@
+resource "nexus_license" "license" {
+  license_base64 = filebase64("${path.module}/license.lic")
+}
@@ -0,0 +1,2 @@
+# import the nexus mail configuration
+terraform import nexus_mail_config.mail mail
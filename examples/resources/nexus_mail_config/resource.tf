@@ -0,0 +1,12 @@
+resource "nexus_mail_config" "mail" {
+  host           = "smtp.example.com"
+  port           = 587
+  username       = "nexus"
+  password       = "changeme"
+  from_address   = "nexus@example.com"
+  subject_prefix = "[nexus]"
+
+  start_tls_enabled                 = true
+  start_tls_required                = true
+  ssl_server_identity_check_enabled = true
+}
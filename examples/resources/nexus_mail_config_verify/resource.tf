@@ -0,0 +1,8 @@
+resource "nexus_mail_config_verify" "mail" {
+  recipient = "admin@example.com"
+
+  triggers = {
+    host = nexus_mail_config.mail.host
+    port = nexus_mail_config.mail.port
+  }
+}
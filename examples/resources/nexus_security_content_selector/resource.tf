@@ -1,5 +1,5 @@
 resource "nexus_security_content_selector" "example" {
   name        = "example"
   description = "example content selector"
-  expression  = "format == \"raw\""
+  expression  = "format == \"raw\" and path =^ \"/example/\""
 }
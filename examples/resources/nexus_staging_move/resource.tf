@@ -0,0 +1,8 @@
+resource "nexus_staging_move" "release" {
+  repository  = "maven-staging"
+  group       = "com.example"
+  name        = "service"
+  version     = "1.4.0"
+  tag         = nexus_tag.release.name
+  destination = "maven-releases"
+}
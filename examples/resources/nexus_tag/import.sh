@@ -0,0 +1,2 @@
+# import using the name of the tag
+terraform import nexus_tag.release service-1.4.0
@@ -0,0 +1,8 @@
+resource "nexus_tag" "release" {
+  name = "service-1.4.0"
+
+  attributes = {
+    commit = "3f2c1e9"
+    branch = "main"
+  }
+}
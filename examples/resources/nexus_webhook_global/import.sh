@@ -0,0 +1,2 @@
+# import using the id of the webhook capability
+terraform import nexus_webhook_global.release_tooling 3f9a1c2b7e4d5a60
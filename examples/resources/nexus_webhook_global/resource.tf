@@ -0,0 +1,5 @@
+resource "nexus_webhook_global" "release_tooling" {
+  event_types = ["repository"]
+  url         = "https://release-tooling.example.com/nexus"
+  secret      = var.webhook_secret
+}
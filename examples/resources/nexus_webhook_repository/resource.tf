@@ -0,0 +1,21 @@
+resource "nexus_repository_maven_hosted" "releases" {
+  name = "maven-releases"
+
+  maven {
+    version_policy = "RELEASE"
+    layout_policy  = "STRICT"
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+}
+
+resource "nexus_webhook_repository" "releases" {
+  repository  = nexus_repository_maven_hosted.releases.name
+  event_types = ["component"]
+  url         = "https://release-tooling.example.com/nexus"
+  secret      = var.webhook_secret
+}
@@ -0,0 +1,97 @@
+package acceptance
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// WebhookDelivery is a request nexus sent to a WebhookReceiver
+type WebhookDelivery struct {
+	// The event id from the X-Nexus-Webhook-ID header, e.g. rm:repository:repository
+	EventID string
+	// Whether the X-Nexus-Webhook-Signature header matches the HMAC-SHA1 of the body
+	ValidSignature bool
+	Body           []byte
+}
+
+// WebhookReceiver is a fake webhook endpoint recording all deliveries of nexus
+type WebhookReceiver struct {
+	server *httptest.Server
+	secret string
+
+	mu         sync.Mutex
+	deliveries []WebhookDelivery
+}
+
+// NewWebhookReceiver starts a WebhookReceiver on all interfaces, so it can be
+// reached from a nexus running in a container
+func NewWebhookReceiver(t *testing.T, secret string) *WebhookReceiver {
+	listener, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		t.Fatalf("could not start webhook receiver: %s", err)
+	}
+
+	receiver := &WebhookReceiver{secret: secret}
+	receiver.server = httptest.NewUnstartedServer(http.HandlerFunc(receiver.handle))
+	receiver.server.Listener.Close()
+	receiver.server.Listener = listener
+	receiver.server.Start()
+	t.Cleanup(receiver.server.Close)
+
+	return receiver
+}
+
+// URL returns the URL nexus reaches the receiver at
+func (r *WebhookReceiver) URL(host string) string {
+	return fmt.Sprintf("http://%s:%d/webhook", host, r.server.Listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (r *WebhookReceiver) handle(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	mac := hmac.New(sha1.New, []byte(r.secret))
+	mac.Write(body)
+	signature, err := hex.DecodeString(req.Header.Get("X-Nexus-Webhook-Signature"))
+
+	r.mu.Lock()
+	r.deliveries = append(r.deliveries, WebhookDelivery{
+		EventID:        req.Header.Get("X-Nexus-Webhook-ID"),
+		ValidSignature: err == nil && hmac.Equal(signature, mac.Sum(nil)),
+		Body:           body,
+	})
+	r.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Deliveries returns all deliveries received so far
+func (r *WebhookReceiver) Deliveries() []WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]WebhookDelivery(nil), r.deliveries...)
+}
+
+// WaitFor waits until a delivery with the given event id was received
+func (r *WebhookReceiver) WaitFor(eventID string, timeout time.Duration) (*WebhookDelivery, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		for _, delivery := range r.Deliveries() {
+			if delivery.EventID == eventID {
+				return &delivery, nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("no webhook delivery for %s within %s", eventID, timeout)
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	blobstoreAPIEndpoint = basePath + "v1/blobstores"
+
+	// BlobstoreCompactTaskType is the type of the task deleting the blobs
+	// which were soft deleted from a blobstore
+	BlobstoreCompactTaskType = "blobstore.compact"
+	// BlobstoreReconcileTaskType is the type of the task restoring the
+	// metadata of components and assets from the blobs of a blobstore
+	BlobstoreReconcileTaskType = "blobstore.rebuildComponentDB"
+)
+
+type BlobstoreQuotaStatus struct {
+	// The name of the blobstore
+	BlobStoreName string `json:"blobStoreName"`
+	// Whether the soft quota of the blobstore is violated
+	IsViolation bool `json:"isViolation"`
+	// Describes the state of the soft quota, e.g. the violation
+	Message string `json:"message"`
+}
+
+type BlobstoreService Service
+
+// List returns all blobstores with their usage, see Client.EnableListCache
+func (s *BlobstoreService) List() ([]blobstore.Generic, error) {
+	body, err := s.Client.getList(ListCacheBlobstores, blobstoreAPIEndpoint, "blobstores")
+	if err != nil {
+		return nil, err
+	}
+
+	var genericBlobstores []blobstore.Generic
+	if err := json.Unmarshal(body, &genericBlobstores); err != nil {
+		return nil, fmt.Errorf("could not unmarshal list of generic blobstores: %v", err)
+	}
+	return genericBlobstores, nil
+}
+
+// GetQuotaStatus returns the soft quota status of the blobstore with the
+// given name or nil if the blobstore does not exist
+func (s *BlobstoreService) GetQuotaStatus(name string) (*BlobstoreQuotaStatus, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s/%s/quota-status", blobstoreAPIEndpoint, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read quota status of blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var status BlobstoreQuotaStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("could not unmarshal quota status: %v", err)
+	}
+	return &status, nil
+}
+
+// Compact runs the task compacting the blobstore with the given name and
+// waits until it finished, see TaskService.RunOnce
+func (s *BlobstoreService) Compact(ctx context.Context, name string, timeout time.Duration, progress func(*Task)) (*Task, error) {
+	return s.Client.Task.RunOnce(ctx, &TaskConfiguration{
+		TypeID: BlobstoreCompactTaskType,
+		Name:   fmt.Sprintf("Compact blobstore %s", name),
+		Properties: map[string]string{
+			"blobstoreName": name,
+		},
+	}, timeout, progress)
+}
+
+// BlobstoreReconcileOptions selects what the task reconciling the component
+// database from a blobstore restores
+type BlobstoreReconcileOptions struct {
+	// Only log the changes without restoring anything
+	DryRun bool
+	// Restore the metadata of missing components and assets
+	RestoreBlobs bool
+	// Undelete blobs which were soft deleted but are still referenced
+	UndeleteBlobs bool
+	// Check the integrity of the blobs against their metadata
+	IntegrityCheck bool
+}
+
+// Reconcile runs the task reconciling the component database from the
+// blobstore with the given name and waits until it finished. See
+// TaskService.RunOnce.
+func (s *BlobstoreService) Reconcile(ctx context.Context, name string, options BlobstoreReconcileOptions, timeout time.Duration, progress func(*Task)) (*Task, error) {
+	return s.Client.Task.RunOnce(ctx, &TaskConfiguration{
+		TypeID: BlobstoreReconcileTaskType,
+		Name:   fmt.Sprintf("Reconcile component database from blobstore %s", name),
+		Properties: map[string]string{
+			"blobstoreName":  name,
+			"dryRun":         strconv.FormatBool(options.DryRun),
+			"restoreBlobs":   strconv.FormatBool(options.RestoreBlobs),
+			"undeleteBlobs":  strconv.FormatBool(options.UndeleteBlobs),
+			"integrityCheck": strconv.FormatBool(options.IntegrityCheck),
+		},
+	}, timeout, progress)
+}
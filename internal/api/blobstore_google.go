@@ -0,0 +1,105 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	blobstoreGoogleAPIEndpoint = basePath + "v1/blobstores/google"
+
+	GoogleAuthenticationMethodAccountKey         = "accountKey"
+	GoogleAuthenticationMethodApplicationDefault = "applicationDefault"
+)
+
+type GoogleBlobstore struct {
+	// The name of the blobstore
+	Name string `json:"name"`
+	// The soft quota of the blobstore
+	SoftQuota *blobstore.SoftQuota `json:"softQuota,omitempty"`
+	// The configuration of the Google Cloud Storage bucket
+	BucketConfiguration GoogleBlobstoreBucketConfiguration `json:"bucketConfiguration"`
+}
+
+type GoogleBlobstoreBucketConfiguration struct {
+	Bucket         GoogleBlobstoreBucket          `json:"bucket"`
+	BucketSecurity *GoogleBlobstoreBucketSecurity `json:"bucketSecurity,omitempty"`
+}
+
+type GoogleBlobstoreBucket struct {
+	// The name of the bucket
+	Name string `json:"name"`
+	// The prefix of the objects of the blobstore in the bucket
+	Prefix string `json:"prefix,omitempty"`
+	// The region of the bucket
+	Region string `json:"region"`
+}
+
+type GoogleBlobstoreBucketSecurity struct {
+	// How nexus authenticates, either accountKey or applicationDefault
+	AuthenticationMethod string `json:"authenticationMethod"`
+	// The content of the JSON credential file of a service account, only used with accountKey
+	AccountKey string `json:"accountKey,omitempty"`
+}
+
+type BlobstoreGoogleService Service
+
+// Get returns the blobstore with the given name or nil if it does not exist
+func (s *BlobstoreGoogleService) Get(name string) (*GoogleBlobstore, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s/%s", blobstoreGoogleAPIEndpoint, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read google blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var bs GoogleBlobstore
+	if err := json.Unmarshal(body, &bs); err != nil {
+		return nil, fmt.Errorf("could not unmarshal google blobstore: %v", err)
+	}
+	return &bs, nil
+}
+
+func (s *BlobstoreGoogleService) Create(bs *GoogleBlobstore) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(bs)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Post(blobstoreGoogleAPIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not create google blobstore '%s': HTTP: %d, %s", bs.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *BlobstoreGoogleService) Update(name string, bs *GoogleBlobstore) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(bs)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Put(fmt.Sprintf("%s/%s", blobstoreGoogleAPIEndpoint, url.PathEscape(name)), ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update google blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
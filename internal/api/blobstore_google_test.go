@@ -0,0 +1,47 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobstoreGoogleCreateAndGet(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/"+blobstoreGoogleAPIEndpoint:
+			var bs GoogleBlobstore
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&bs))
+			assert.Equal(t, "gcs", bs.Name)
+			assert.Equal(t, GoogleAuthenticationMethodAccountKey, bs.BucketConfiguration.BucketSecurity.AuthenticationMethod)
+			assert.Equal(t, `{"type":"service_account"}`, bs.BucketConfiguration.BucketSecurity.AccountKey)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/"+blobstoreGoogleAPIEndpoint+"/gcs":
+			w.Write([]byte(`{"name":"gcs","bucketConfiguration":{"bucket":{"name":"nexus","prefix":"blobs","region":"us-central1"},"bucketSecurity":{"authenticationMethod":"accountKey"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	err := c.BlobstoreGoogle.Create(&GoogleBlobstore{
+		Name: "gcs",
+		BucketConfiguration: GoogleBlobstoreBucketConfiguration{
+			Bucket: GoogleBlobstoreBucket{Name: "nexus", Prefix: "blobs", Region: "us-central1"},
+			BucketSecurity: &GoogleBlobstoreBucketSecurity{
+				AuthenticationMethod: GoogleAuthenticationMethodAccountKey,
+				AccountKey:           `{"type":"service_account"}`,
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	bs, err := c.BlobstoreGoogle.Get("gcs")
+	assert.NoError(t, err)
+	assert.Equal(t, GoogleBlobstoreBucket{Name: "nexus", Prefix: "blobs", Region: "us-central1"}, bs.BucketConfiguration.Bucket)
+
+	bs, err = c.BlobstoreGoogle.Get("missing")
+	assert.NoError(t, err)
+	assert.Nil(t, bs)
+}
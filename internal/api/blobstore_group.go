@@ -0,0 +1,28 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	blobstoreGroupAPIEndpoint = basePath + "v1/blobstores/group"
+)
+
+type BlobstoreGroupService Service
+
+// Convert turns the blobstore with the given name into a group of the same
+// name, so repositories using it keep working. The existing blobstore is
+// renamed to newNameForOriginal and becomes the only member of the group.
+func (s *BlobstoreGroupService) Convert(name string, newNameForOriginal string) error {
+	body, resp, err := s.Client.Post(fmt.Sprintf("%s/convert/%s/%s", blobstoreGroupAPIEndpoint, url.PathEscape(name), url.PathEscape(newNameForOriginal)), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not convert blobstore '%s' to a group: HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
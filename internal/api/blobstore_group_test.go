@@ -0,0 +1,24 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobstoreGroupConvert(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Path {
+		case "/" + blobstoreGroupAPIEndpoint + "/convert/store/store-original":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`blobstore missing does not exist`))
+		}
+	})
+
+	assert.NoError(t, c.BlobstoreGroup.Convert("store", "store-original"))
+	assert.EqualError(t, c.BlobstoreGroup.Convert("missing", "missing-original"), "could not convert blobstore 'missing' to a group: HTTP: 400, blobstore missing does not exist")
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	blobstoreS3APIEndpoint = basePath + "v1/blobstores/s3"
+)
+
+// S3Blobstore extends blobstore.S3 of go-nexus-client with the failover
+// buckets of nexus pro
+type S3Blobstore struct {
+	// The name of the S3 blob store
+	Name string `json:"name"`
+	// Settings to control the soft quota
+	SoftQuota *blobstore.SoftQuota `json:"softQuota,omitempty"`
+	// The S3 specific configuration details for the S3 object that'll contain the blob store
+	BucketConfiguration S3BlobstoreBucketConfiguration `json:"bucketConfiguration"`
+}
+
+type S3BlobstoreBucketConfiguration struct {
+	blobstore.S3BucketConfiguration
+
+	// Buckets in other regions nexus replicates to and fails over to
+	FailoverBuckets []S3FailoverBucket `json:"failoverBuckets,omitempty"`
+	// The region nexus currently uses, only set when read from nexus
+	ActiveRegion string `json:"activeRegion,omitempty"`
+}
+
+type S3FailoverBucket struct {
+	// The region of the bucket
+	Region string `json:"region"`
+	// The name of the bucket
+	BucketName string `json:"bucketName"`
+}
+
+type BlobstoreS3Service Service
+
+// Get returns the blobstore with the given name or nil if it does not exist
+func (s *BlobstoreS3Service) Get(name string) (*S3Blobstore, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s/%s", blobstoreS3APIEndpoint, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read s3 blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var bs S3Blobstore
+	if err := json.Unmarshal(body, &bs); err != nil {
+		return nil, fmt.Errorf("could not unmarshal s3 blobstore: %v", err)
+	}
+	return &bs, nil
+}
+
+func (s *BlobstoreS3Service) Create(bs *S3Blobstore) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(bs)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Post(blobstoreS3APIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not create s3 blobstore '%s': HTTP: %d, %s", bs.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *BlobstoreS3Service) Update(name string, bs *S3Blobstore) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(bs)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Put(fmt.Sprintf("%s/%s", blobstoreS3APIEndpoint, url.PathEscape(name)), ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update s3 blobstore '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
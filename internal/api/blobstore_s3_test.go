@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobstoreS3Update(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/"+blobstoreS3APIEndpoint+"/s3", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		var bs map[string]interface{}
+		assert.NoError(t, json.Unmarshal(body, &bs))
+
+		bucketConfiguration := bs["bucketConfiguration"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{"name": "nexus", "region": "eu-central-1", "expiration": float64(3)}, bucketConfiguration["bucket"])
+		assert.Equal(t, map[string]interface{}{"maxConnectionPoolSize": float64(25)}, bucketConfiguration["advancedBucketConnection"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"region": "eu-west-1", "bucketName": "nexus-failover"}}, bucketConfiguration["failoverBuckets"])
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	maxConnectionPoolSize := int32(25)
+	err := c.BlobstoreS3.Update("s3", &S3Blobstore{
+		Name: "s3",
+		BucketConfiguration: S3BlobstoreBucketConfiguration{
+			S3BucketConfiguration: blobstore.S3BucketConfiguration{
+				Bucket: blobstore.S3Bucket{Name: "nexus", Region: "eu-central-1", Expiration: 3},
+				AdvancedBucketConnection: &blobstore.S3AdvancedBucketConnection{
+					MaxConnectionPoolSize: &maxConnectionPoolSize,
+				},
+			},
+			FailoverBuckets: []S3FailoverBucket{{Region: "eu-west-1", BucketName: "nexus-failover"}},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestBlobstoreS3Get(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+blobstoreS3APIEndpoint+"/s3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"name":"s3","bucketConfiguration":{"bucket":{"name":"nexus","region":"eu-central-1","expiration":3},"failoverBuckets":[{"region":"eu-west-1","bucketName":"nexus-failover"}],"activeRegion":"eu-central-1"}}`))
+	})
+
+	bs, err := c.BlobstoreS3.Get("s3")
+	assert.NoError(t, err)
+	assert.Equal(t, "nexus", bs.BucketConfiguration.Bucket.Name)
+	assert.Equal(t, "eu-central-1", bs.BucketConfiguration.ActiveRegion)
+	assert.Equal(t, []S3FailoverBucket{{Region: "eu-west-1", BucketName: "nexus-failover"}}, bs.BucketConfiguration.FailoverBuckets)
+
+	bs, err = c.BlobstoreS3.Get("missing")
+	assert.NoError(t, err)
+	assert.Nil(t, bs)
+}
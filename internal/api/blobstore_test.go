@@ -0,0 +1,30 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobstoreGetQuotaStatus(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Path {
+		case "/" + blobstoreAPIEndpoint + "/full/quota-status":
+			w.Write([]byte(`{"isViolation":true,"message":"Blob store full has 10 bytes of space remaining, which is below the quota of 100 bytes","blobStoreName":"full"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	status, err := c.Blobstore.GetQuotaStatus("full")
+	assert.NoError(t, err)
+	assert.True(t, status.IsViolation)
+	assert.Equal(t, "full", status.BlobStoreName)
+	assert.Contains(t, status.Message, "below the quota")
+
+	status, err = c.Blobstore.GetQuotaStatus("missing")
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+}
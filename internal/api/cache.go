@@ -0,0 +1,201 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Collections of the list cache, a write to any endpoint of a collection
+// invalidates all lists of the collection
+const (
+	ListCacheBlobstores   = "blobstores"
+	ListCacheRepositories = "repositories"
+)
+
+// listCacheEntry is a list response which is requested or was received.
+// done is closed once body and err are set.
+type listCacheEntry struct {
+	collection string
+	done       chan struct{}
+	body       []byte
+	err        error
+}
+
+// listCache keeps list responses for the lifetime of the provider, i.e. a
+// single plan or apply, so that resources reading the same list share one
+// request. Concurrent reads of a list which is not cached yet wait for the
+// first request instead of sending their own. Lists of a collection are not
+// cached while it is written, see Client.StartListCacheWrite.
+type listCache struct {
+	mu      sync.Mutex
+	entries map[string]*listCacheEntry
+	// writes counts the running writes per collection
+	writes map[string]int
+}
+
+func newListCache() *listCache {
+	return &listCache{
+		entries: map[string]*listCacheEntry{},
+		writes:  map[string]int{},
+	}
+}
+
+// get returns the cached response of endpoint or calls fetch to request
+// it. Failed requests are not cached.
+func (c *listCache) get(collection string, endpoint string, fetch func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	if c.writes[collection] > 0 {
+		c.mu.Unlock()
+		return fetch()
+	}
+	entry, ok := c.entries[endpoint]
+	if ok {
+		c.mu.Unlock()
+		<-entry.done
+		return entry.body, entry.err
+	}
+	entry = &listCacheEntry{collection: collection, done: make(chan struct{})}
+	c.entries[endpoint] = entry
+	c.mu.Unlock()
+
+	entry.body, entry.err = fetch()
+	close(entry.done)
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[endpoint] == entry {
+			delete(c.entries, endpoint)
+		}
+		c.mu.Unlock()
+	}
+	return entry.body, entry.err
+}
+
+// invalidate removes all lists of the given collections, requests which are
+// still running are not cached anymore when they finish
+func (c *listCache) invalidate(collections ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLocked(collections...)
+}
+
+func (c *listCache) invalidateLocked(collections ...string) {
+	for endpoint, entry := range c.entries {
+		for _, collection := range collections {
+			if entry.collection == collection {
+				delete(c.entries, endpoint)
+				break
+			}
+		}
+	}
+}
+
+// startWrite stops caching the lists of the given collections until the
+// returned function is called, the lists are invalidated by both
+func (c *listCache) startWrite(collections ...string) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, collection := range collections {
+		c.writes[collection]++
+	}
+	c.invalidateLocked(collections...)
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, collection := range collections {
+			c.writes[collection]--
+		}
+		c.invalidateLocked(collections...)
+	}
+}
+
+// getListCacheCollection returns the collection of the list cache an
+// endpoint belongs to, e.g. blobstores for service/rest/v1/blobstores/s3/x
+func getListCacheCollection(endpoint string) string {
+	path := strings.TrimPrefix(strings.TrimPrefix(endpoint, "/"), basePath)
+	segments := strings.Split(path, "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	// the first segment is the version of the API, e.g. v1 or beta
+	collection := strings.SplitN(segments[1], "?", 2)[0]
+	if collection == "repositorySettings" {
+		return ListCacheRepositories
+	}
+	return collection
+}
+
+// EnableListCache makes the client cache list responses until a write to
+// the same collection, see listCache
+func (c *Client) EnableListCache() {
+	c.listCache = newListCache()
+}
+
+// InvalidateListCache removes the cached lists of the given collections. It
+// is used after changes which are not made with c, e.g. with the
+// *nexus.NexusClient.
+func (c *Client) InvalidateListCache(collections ...string) {
+	if c.listCache != nil {
+		c.listCache.invalidate(collections...)
+	}
+}
+
+// StartListCacheWrite invalidates the cached lists of the given collections
+// and stops caching them until the returned function is called, which
+// invalidates them again. It is used around changes which are not made with
+// c, so that no list read before the change is cached afterwards.
+func (c *Client) StartListCacheWrite(collections ...string) func() {
+	if c.listCache == nil {
+		return func() {}
+	}
+	return c.listCache.startWrite(collections...)
+}
+
+// ListCacheEnabled reports whether EnableListCache was called
+func (c *Client) ListCacheEnabled() bool {
+	return c.listCache != nil
+}
+
+// getList returns the body of a successful GET of endpoint, which lists the
+// given collection and is cached if the list cache is enabled. description
+// names the list in errors.
+func (c *Client) getList(collection string, endpoint string, description string) ([]byte, error) {
+	fetch := func() ([]byte, error) {
+		body, resp, err := c.Get(endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not list %s: HTTP: %d, %s", description, resp.StatusCode, string(body))
+		}
+		return body, nil
+	}
+
+	if c.listCache == nil {
+		return fetch()
+	}
+	return c.listCache.get(collection, endpoint, fetch)
+}
+
+// Post invalidates the cached lists of the collection of endpoint
+func (c *Client) Post(endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	defer c.InvalidateListCache(getListCacheCollection(endpoint))
+	return c.Client.Post(endpoint, payload)
+}
+
+// Put invalidates the cached lists of the collection of endpoint
+func (c *Client) Put(endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	defer c.InvalidateListCache(getListCacheCollection(endpoint))
+	return c.Client.Put(endpoint, payload)
+}
+
+// Delete invalidates the cached lists of the collection of endpoint
+func (c *Client) Delete(endpoint string) ([]byte, *http.Response, error) {
+	defer c.InvalidateListCache(getListCacheCollection(endpoint))
+	return c.Client.Delete(endpoint)
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// getListCacheTestClient returns a client with enabled list cache for a fake
+// nexus listing the blobstores and repositories and counting the list
+// requests
+func getListCacheTestClient(t testing.TB, latency time.Duration) (*Client, *int64) {
+	var lists int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		atomic.AddInt64(&lists, 1)
+		time.Sleep(latency)
+		switch r.URL.Path {
+		case "/" + blobstoreAPIEndpoint:
+			w.Write([]byte(`[{"name":"default","type":"File","blobCount":3}]`))
+		case "/" + repositoryAPIEndpoint:
+			w.Write([]byte(`[{"name":"raw","format":"raw","type":"hosted"}]`))
+		case "/" + repositorySettingsAPIEndpoint:
+			w.Write([]byte(`[{"name":"raw","format":"raw","type":"hosted","storage":{"blobStoreName":"default"}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	})
+	c.EnableListCache()
+	return c, &lists
+}
+
+func TestListCache(t *testing.T) {
+	c, lists := getListCacheTestClient(t, 0)
+
+	for i := 0; i < 3; i++ {
+		blobstores, err := c.Blobstore.List()
+		assert.NoError(t, err)
+		assert.Len(t, blobstores, 1)
+		assert.Equal(t, 3, blobstores[0].BlobCount)
+
+		repositories, err := c.Repository.List()
+		assert.NoError(t, err)
+		assert.Len(t, repositories, 1)
+
+		names, err := c.Repository.ListByBlobstore("default")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"raw"}, names)
+	}
+	assert.Equal(t, int64(3), atomic.LoadInt64(lists))
+}
+
+func TestListCacheInvalidatedByWrite(t *testing.T) {
+	c, lists := getListCacheTestClient(t, 0)
+
+	_, err := c.Blobstore.List()
+	assert.NoError(t, err)
+	_, err = c.Repository.List()
+	assert.NoError(t, err)
+	_, err = c.Repository.ListSettings()
+	assert.NoError(t, err)
+
+	// writes to other collections keep the lists
+	_, _, err = c.Put(mailConfigAPIEndpoint, nil)
+	assert.NoError(t, err)
+	_, err = c.Blobstore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), atomic.LoadInt64(lists))
+
+	_, _, err = c.Delete(blobstoreAPIEndpoint + "/default")
+	assert.NoError(t, err)
+	_, err = c.Blobstore.List()
+	assert.NoError(t, err)
+	_, err = c.Repository.List()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), atomic.LoadInt64(lists))
+
+	// repository settings belong to the repositories
+	_, _, err = c.Put(repositorySettingsAPIEndpoint, nil)
+	assert.NoError(t, err)
+	_, err = c.Repository.List()
+	assert.NoError(t, err)
+	_, err = c.Repository.ListSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), atomic.LoadInt64(lists))
+
+	c.InvalidateListCache(ListCacheBlobstores)
+	_, err = c.Blobstore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), atomic.LoadInt64(lists))
+}
+
+func TestListCacheWrite(t *testing.T) {
+	c, lists := getListCacheTestClient(t, 0)
+
+	_, err := c.Repository.List()
+	assert.NoError(t, err)
+
+	// the lists are neither cached nor read from the cache during a write
+	done := c.StartListCacheWrite(ListCacheRepositories)
+	for i := 0; i < 2; i++ {
+		_, err = c.Repository.List()
+		assert.NoError(t, err)
+	}
+	_, err = c.Blobstore.List()
+	assert.NoError(t, err)
+	_, err = c.Blobstore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), atomic.LoadInt64(lists))
+
+	done()
+	for i := 0; i < 2; i++ {
+		_, err = c.Repository.List()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(5), atomic.LoadInt64(lists))
+}
+
+func TestListCacheConcurrent(t *testing.T) {
+	c, lists := getListCacheTestClient(t, 10*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			blobstores, err := c.Blobstore.List()
+			assert.NoError(t, err)
+			assert.Len(t, blobstores, 1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1), atomic.LoadInt64(lists))
+}
+
+func TestListCacheError(t *testing.T) {
+	var lists int64
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&lists, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`starting`))
+			return
+		}
+		w.Write([]byte(`[{"name":"default","type":"File"}]`))
+	})
+	c.EnableListCache()
+
+	_, err := c.Blobstore.List()
+	assert.EqualError(t, err, "could not list blobstores: HTTP: 503, starting")
+
+	// failed requests are not cached
+	blobstores, err := c.Blobstore.List()
+	assert.NoError(t, err)
+	assert.Len(t, blobstores, 1)
+	_, err = c.Blobstore.List()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&lists))
+}
+
+func TestListCacheDisabled(t *testing.T) {
+	var lists int64
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&lists, 1)
+		w.Write([]byte(`[]`))
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := c.Blobstore.List()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(3), atomic.LoadInt64(&lists))
+}
+
+func TestGetListCacheCollection(t *testing.T) {
+	assert.Equal(t, ListCacheBlobstores, getListCacheCollection(blobstoreAPIEndpoint))
+	assert.Equal(t, ListCacheBlobstores, getListCacheCollection("/"+blobstoreGroupAPIEndpoint+"/convert/a/b"))
+	assert.Equal(t, ListCacheRepositories, getListCacheCollection(repositoryAPIEndpoint+"/raw/hosted/raw"))
+	assert.Equal(t, ListCacheRepositories, getListCacheCollection(repositorySettingsAPIEndpoint))
+	assert.Equal(t, "tasks", getListCacheCollection(taskAPIEndpoint+"/1/run"))
+	assert.Equal(t, "", getListCacheCollection(basePath))
+}
+
+// BenchmarkListCache reads the blobstore list like a plan refreshing 150
+// blobstores with the default parallelism of terraform against a nexus
+// answering within 2ms, with and without list cache
+func BenchmarkListCache(b *testing.B) {
+	const resources = 150
+	const parallelism = 10
+
+	for _, cached := range []bool{false, true} {
+		b.Run(fmt.Sprintf("cached=%t", cached), func(b *testing.B) {
+			c, lists := getListCacheTestClient(b, 2*time.Millisecond)
+			if !cached {
+				c.listCache = nil
+			}
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				c.InvalidateListCache(ListCacheBlobstores)
+
+				reads := make(chan struct{}, resources)
+				for r := 0; r < resources; r++ {
+					reads <- struct{}{}
+				}
+				close(reads)
+
+				var wg sync.WaitGroup
+				for w := 0; w < parallelism; w++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for range reads {
+							if _, err := c.Blobstore.List(); err != nil {
+								b.Error(err)
+							}
+						}
+					}()
+				}
+				wg.Wait()
+			}
+			b.ReportMetric(float64(atomic.LoadInt64(lists))/float64(b.N), "requests/op")
+		})
+	}
+}
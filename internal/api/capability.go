@@ -0,0 +1,269 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema"
+)
+
+const (
+	capabilityScriptName = "terraform-provider-nexus-capability"
+	capabilityScript     = `
+import groovy.json.JsonOutput
+import groovy.json.JsonSlurper
+import org.sonatype.nexus.capability.CapabilityDescriptorRegistry
+import org.sonatype.nexus.capability.CapabilityReference
+import org.sonatype.nexus.capability.CapabilityRegistry
+
+import static org.sonatype.nexus.capability.CapabilityIdentity.capabilityIdentity
+import static org.sonatype.nexus.capability.CapabilityType.capabilityType
+
+def capabilityRegistry = container.lookup(CapabilityRegistry.class.getName())
+def descriptorRegistry = container.lookup(CapabilityDescriptorRegistry.class.getName())
+def parsedArgs = new JsonSlurper().parseText(args)
+def capability = parsedArgs.capability
+
+switch (parsedArgs.action) {
+    case 'create':
+        def reference = capabilityRegistry.add(capabilityType(capability.typeId), capability.enabled, capability.notes, capability['properties'] ?: [:])
+        return JsonOutput.toJson(fromReference(reference))
+    case 'update':
+        def reference = capabilityRegistry.update(capabilityIdentity(capability.id), capability.enabled, capability.notes, capability['properties'] ?: [:])
+        return JsonOutput.toJson(fromReference(reference))
+    case 'delete':
+        capabilityRegistry.remove(capabilityIdentity(capability.id))
+        return JsonOutput.toJson(null)
+    case 'types':
+        return JsonOutput.toJson(descriptorRegistry.getAll().findAll { it.isExposed() }.collect { descriptor ->
+            [
+                id        : descriptor.type().toString(),
+                name      : descriptor.name(),
+                about     : descriptor.about(),
+                properties: (descriptor.formFields() ?: []).collect { field ->
+                    [
+                        id             : field.getId(),
+                        type           : field.getType(),
+                        label          : field.getLabel(),
+                        helpText       : field.getHelpText(),
+                        required       : field.isRequired(),
+                        regexValidation: field.getRegexValidation(),
+                        initialValue   : field.getInitialValue()?.toString(),
+                    ]
+                },
+            ]
+        })
+    default:
+        return JsonOutput.toJson(capabilityRegistry.getAll().collect { fromReference(it) })
+}
+
+def fromReference(CapabilityReference reference) {
+    def context = reference.context()
+    return [
+        id        : context.id().toString(),
+        typeId    : context.type().toString(),
+        enabled   : context.isEnabled(),
+        notes     : context.notes(),
+        properties: context.properties(),
+        active    : context.isActive(),
+        error     : context.hasFailure() ? context.failure()?.message : null,
+    ]
+}
+`
+)
+
+type Capability struct {
+	// The identifier nexus assigned to the capability
+	ID string `json:"id,omitempty"`
+	// The type of the capability, e.g. baseurl
+	TypeID string `json:"typeId"`
+	// Whether the capability is enabled
+	Enabled bool `json:"enabled"`
+	// Notes about the capability
+	Notes string `json:"notes,omitempty"`
+	// The configuration of the capability
+	Properties map[string]string `json:"properties,omitempty"`
+	// Whether the capability is active, only set when read from nexus
+	Active bool `json:"active,omitempty"`
+	// The failure preventing the capability from being active, only set when read from nexus
+	Error string `json:"error,omitempty"`
+}
+
+type CapabilityType struct {
+	// The id of the capability type, e.g. baseurl
+	ID string `json:"id"`
+	// The human readable name of the capability type
+	Name string `json:"name"`
+	// The description of the capability type
+	About string `json:"about,omitempty"`
+	// The properties a capability of this type can be configured with
+	Properties []CapabilityTypeProperty `json:"properties"`
+}
+
+type CapabilityTypeProperty struct {
+	// The key of the property in Capability.Properties
+	ID string `json:"id"`
+	// The form field type of the property, e.g. string, number or checkbox
+	Type string `json:"type"`
+	// The human readable name of the property
+	Label string `json:"label"`
+	// The description of the property
+	HelpText string `json:"helpText,omitempty"`
+	// Whether the property must be set
+	Required bool `json:"required"`
+	// A regular expression the value of the property must match
+	RegexValidation string `json:"regexValidation,omitempty"`
+	// The value nexus uses if the property is not set
+	InitialValue string `json:"initialValue,omitempty"`
+}
+
+// Validate checks properties against the property descriptors of the type
+func (t *CapabilityType) Validate(properties map[string]string) error {
+	known := make(map[string]bool, len(t.Properties))
+	for _, property := range t.Properties {
+		known[property.ID] = true
+
+		value, ok := properties[property.ID]
+		if !ok || value == "" {
+			// nexus fills in the initial value of properties which are not set
+			if property.Required && property.InitialValue == "" {
+				return fmt.Errorf("property %q is required for capability type %s", property.ID, t.ID)
+			}
+			continue
+		}
+
+		switch property.Type {
+		case "number":
+			if _, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("property %q of capability type %s must be a number, got %q", property.ID, t.ID, value)
+			}
+		case "checkbox":
+			if _, err := strconv.ParseBool(value); err != nil {
+				return fmt.Errorf("property %q of capability type %s must be true or false, got %q", property.ID, t.ID, value)
+			}
+		}
+
+		if property.RegexValidation != "" {
+			re, err := regexp.Compile("^(?:" + property.RegexValidation + ")$")
+			if err == nil && !re.MatchString(value) {
+				return fmt.Errorf("property %q of capability type %s must match %s, got %q", property.ID, t.ID, property.RegexValidation, value)
+			}
+		}
+	}
+
+	for key := range properties {
+		if !known[key] {
+			return fmt.Errorf("property %q is not supported by capability type %s", key, t.ID)
+		}
+	}
+	return nil
+}
+
+type capabilityArgs struct {
+	Action     string      `json:"action"`
+	Capability *Capability `json:"capability,omitempty"`
+}
+
+type CapabilityService Service
+
+func (s *CapabilityService) script() schema.Script {
+	return schema.Script{
+		Name:    capabilityScriptName,
+		Content: capabilityScript,
+		Type:    "groovy",
+	}
+}
+
+// StoreScript stores the script of the service in nexus. Create, Update and
+// Delete store it themselves, reads only run a stored script, see
+// Client.runStoredScript, so changes reading capabilities first call it.
+func (s *CapabilityService) StoreScript() error {
+	return s.Client.storeScript(s.script())
+}
+
+// List returns all capabilities, it returns ErrScriptNotStored until the
+// script is stored
+func (s *CapabilityService) List() ([]Capability, error) {
+	var capabilities []Capability
+	if err := s.Client.runStoredScript(s.script(), capabilityArgs{Action: "list"}, &capabilities); err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
+
+// Get returns the capability with the given id or nil if it does not exist
+func (s *CapabilityService) Get(id string) (*Capability, error) {
+	capabilities, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, capability := range capabilities {
+		if capability.ID == id {
+			return &capability, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetByType returns the first capability of the given type or nil if none exists
+func (s *CapabilityService) GetByType(typeID string) (*Capability, error) {
+	capabilities, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, capability := range capabilities {
+		if capability.TypeID == typeID {
+			return &capability, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListTypes returns all capability types which can be created, it returns
+// ErrScriptNotStored until the script is stored
+func (s *CapabilityService) ListTypes() ([]CapabilityType, error) {
+	var types []CapabilityType
+	if err := s.Client.runStoredScript(s.script(), capabilityArgs{Action: "types"}, &types); err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+// GetType returns the capability type with the given id or nil if it does not exist
+func (s *CapabilityService) GetType(id string) (*CapabilityType, error) {
+	types, err := s.ListTypes()
+	if err != nil {
+		return nil, err
+	}
+	return getCapabilityType(types, id), nil
+}
+
+func getCapabilityType(types []CapabilityType, id string) *CapabilityType {
+	for _, capabilityType := range types {
+		if capabilityType.ID == id {
+			return &capabilityType
+		}
+	}
+	return nil
+}
+
+func (s *CapabilityService) Create(capability *Capability) (*Capability, error) {
+	var created Capability
+	if err := s.Client.runScript(s.script(), capabilityArgs{Action: "create", Capability: capability}, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (s *CapabilityService) Update(capability *Capability) (*Capability, error) {
+	var updated Capability
+	if err := s.Client.runScript(s.script(), capabilityArgs{Action: "update", Capability: capability}, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (s *CapabilityService) Delete(id string) error {
+	return s.Client.runScript(s.script(), capabilityArgs{Action: "delete", Capability: &Capability{ID: id}}, nil)
+}
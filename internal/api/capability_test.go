@@ -0,0 +1,90 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilityGetByType(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(map[string]string{"name": capabilityScriptName, "content": capabilityScript, "type": "groovy"})
+			return
+		}
+		assert.True(t, strings.HasSuffix(r.URL.Path, "/"+capabilityScriptName+"/run"))
+
+		result, _ := json.Marshal([]Capability{
+			{ID: "1", TypeID: "audit", Enabled: true},
+			{ID: "2", TypeID: "baseurl", Enabled: true, Properties: map[string]string{"url": "https://nexus.example.com"}},
+		})
+		json.NewEncoder(w).Encode(map[string]string{"name": capabilityScriptName, "result": string(result)})
+	})
+
+	capability, err := c.Capability.GetByType("baseurl")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", capability.ID)
+	assert.Equal(t, "https://nexus.example.com", capability.Properties["url"])
+
+	capability, err = c.Capability.GetByType("rapture.settings")
+	assert.NoError(t, err)
+	assert.Nil(t, capability)
+}
+
+func TestCapabilityTypeValidate(t *testing.T) {
+	capabilityType := CapabilityType{
+		ID: "outreach",
+		Properties: []CapabilityTypeProperty{
+			{ID: "baseUrl", Type: "url", Required: true, RegexValidation: "https?://.*"},
+			{ID: "timeout", Type: "number"},
+			{ID: "alwaysRemote", Type: "checkbox"},
+			{ID: "port", Type: "number", Required: true, InitialValue: "8081"},
+		},
+	}
+
+	assert.NoError(t, capabilityType.Validate(map[string]string{"baseUrl": "https://example.com"}))
+	assert.NoError(t, capabilityType.Validate(map[string]string{"baseUrl": "https://example.com", "timeout": "30", "alwaysRemote": "true"}))
+
+	assert.ErrorContains(t, capabilityType.Validate(map[string]string{}), `"baseUrl" is required`)
+	assert.ErrorContains(t, capabilityType.Validate(map[string]string{"baseUrl": "ftp://example.com"}), "must match")
+	assert.ErrorContains(t, capabilityType.Validate(map[string]string{"baseUrl": "https://example.com", "timeout": "soon"}), "must be a number")
+	assert.ErrorContains(t, capabilityType.Validate(map[string]string{"baseUrl": "https://example.com", "alwaysRemote": "yes"}), "must be true or false")
+	assert.ErrorContains(t, capabilityType.Validate(map[string]string{"baseUrl": "https://example.com", "unknown": "value"}), `"unknown" is not supported`)
+}
+
+func TestCapabilityGetType(t *testing.T) {
+	capabilityTypes, _ := json.Marshal([]CapabilityType{{ID: "baseurl", Name: "Base URL"}})
+	content := capabilityScript
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "/"+scriptAPIEndpoint+"/"+capabilityScriptName, r.URL.Path)
+			if content == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"name": capabilityScriptName, "content": content, "type": "groovy"})
+		case http.MethodPost:
+			assert.Equal(t, "/"+scriptAPIEndpoint+"/"+capabilityScriptName+"/run", r.URL.Path)
+			json.NewEncoder(w).Encode(map[string]string{"name": capabilityScriptName, "result": string(capabilityTypes)})
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	capabilityType, err := c.Capability.GetType("baseurl")
+	assert.NoError(t, err)
+	assert.Equal(t, "Base URL", capabilityType.Name)
+
+	// scripts which are missing or outdated are not stored
+	content = "return 1"
+	_, err = c.Capability.GetType("baseurl")
+	assert.ErrorIs(t, err, ErrScriptNotStored)
+
+	content = ""
+	_, err = c.Capability.GetType("baseurl")
+	assert.ErrorIs(t, err, ErrScriptNotStored)
+}
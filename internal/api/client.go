@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	basePath = client.BasePath
+
+	// defaultRequestTimeout limits requests whose context has no deadline,
+	// uploads and downloads pass a context with the deadline of the resource
+	defaultRequestTimeout = 30 * time.Second
+)
+
+var (
+	clients sync.Map
+)
+
+// Client gives access to the Nexus REST APIs which are not covered by
+// go-nexus-client yet. It is created with the same configuration as the
+// *nexus.NexusClient which is handed to every resource as provider meta.
+type Client struct {
+	*client.Client
+
+	config     client.Config
+	httpClient *http.Client
+	// listCache is nil unless EnableListCache was called
+	listCache *listCache
+
+	// API Services
+	Blobstore       *BlobstoreService
+	BlobstoreGoogle *BlobstoreGoogleService
+	BlobstoreGroup  *BlobstoreGroupService
+	BlobstoreS3     *BlobstoreS3Service
+	Capability      *CapabilityService
+	Component       *ComponentService
+	Docker          *DockerService
+	HTTPSettings    *HTTPSettingsService
+	IQServer        *IQServerService
+	License         *LicenseService
+	MailConfig      *MailConfigService
+	Maven           *MavenService
+	Raw             *RawService
+	Repository      *RepositoryService
+	Staging         *StagingService
+	Tag             *TagService
+	Task            *TaskService
+}
+
+// Service is the base of all API services of the Client
+type Service struct {
+	Client *Client
+}
+
+// NewClient returns an instance of client for the given configuration
+func NewClient(config client.Config) *Client {
+	c := &Client{
+		Client: client.NewClient(config),
+		config: config,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.Insecure,
+				},
+			},
+		},
+	}
+
+	c.Blobstore = &BlobstoreService{Client: c}
+	c.BlobstoreGoogle = &BlobstoreGoogleService{Client: c}
+	c.BlobstoreGroup = &BlobstoreGroupService{Client: c}
+	c.BlobstoreS3 = &BlobstoreS3Service{Client: c}
+	c.Capability = &CapabilityService{Client: c}
+	c.Component = &ComponentService{Client: c}
+	c.Docker = &DockerService{Client: c}
+	c.HTTPSettings = &HTTPSettingsService{Client: c}
+	c.IQServer = &IQServerService{Client: c}
+	c.License = &LicenseService{Client: c}
+	c.MailConfig = &MailConfigService{Client: c}
+	c.Maven = &MavenService{Client: c}
+	c.Raw = &RawService{Client: c}
+	c.Repository = &RepositoryService{Client: c}
+	c.Staging = &StagingService{Client: c}
+	c.Tag = &TagService{Client: c}
+	c.Task = &TaskService{Client: c}
+
+	return c
+}
+
+// Register makes c available to resources receiving nexusClient as provider meta
+func Register(nexusClient *nexus.NexusClient, c *Client) {
+	clients.Store(nexusClient, c)
+}
+
+// FromMeta returns the Client registered for the provider meta m
+func FromMeta(m interface{}) *Client {
+	c, ok := clients.Load(m)
+	if !ok {
+		panic("no api client registered for provider meta")
+	}
+	return c.(*Client)
+}
+
+// Do sends a prepared request. It is used for requests which need other
+// headers than the JSON defaults, e.g. multipart uploads or downloads.
+func (c *Client) Do(req *http.Request) ([]byte, *http.Response, error) {
+	req, cancel := withRequestTimeout(req)
+	defer cancel()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	return body, resp, err
+}
+
+// withRequestTimeout limits req to defaultRequestTimeout unless its context
+// has a deadline already
+func withRequestTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	if _, ok := req.Context().Deadline(); ok {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), defaultRequestTimeout)
+	return req.WithContext(ctx), cancel
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+const (
+	componentAPIEndpoint   = basePath + "v1/components"
+	searchAPIEndpoint      = basePath + "v1/search"
+	searchAssetAPIEndpoint = searchAPIEndpoint + "/assets"
+)
+
+type Component struct {
+	ID         string  `json:"id"`
+	Repository string  `json:"repository"`
+	Format     string  `json:"format"`
+	Group      string  `json:"group,omitempty"`
+	Name       string  `json:"name"`
+	Version    string  `json:"version,omitempty"`
+	Assets     []Asset `json:"assets,omitempty"`
+}
+
+type Asset struct {
+	ID           string            `json:"id"`
+	Path         string            `json:"path"`
+	DownloadURL  string            `json:"downloadUrl"`
+	Repository   string            `json:"repository"`
+	Format       string            `json:"format"`
+	ContentType  string            `json:"contentType,omitempty"`
+	LastModified string            `json:"lastModified,omitempty"`
+	FileSize     int64             `json:"fileSize,omitempty"`
+	Checksum     map[string]string `json:"checksum,omitempty"`
+}
+
+type componentList struct {
+	Items             []Component `json:"items"`
+	ContinuationToken *string     `json:"continuationToken"`
+}
+
+type assetList struct {
+	Items             []Asset `json:"items"`
+	ContinuationToken *string `json:"continuationToken"`
+}
+
+// ComponentUpload describes the multipart form of a component upload. The
+// keys of Fields and Files are the form field names of the upload API, e.g.
+// raw.directory or maven2.asset1, and the values of Files are local paths.
+type ComponentUpload struct {
+	Repository string
+	Fields     map[string]string
+	Files      map[string]string
+}
+
+type ComponentService Service
+
+// Upload streams the files of upload to nexus as multipart form. It is not
+// limited by the default request timeout but by the deadline of ctx.
+func (s *ComponentService) Upload(ctx context.Context, upload ComponentUpload) error {
+	pipeReader, pipeWriter := io.Pipe()
+	form := multipart.NewWriter(pipeWriter)
+
+	go func() {
+		pipeWriter.CloseWithError(writeComponentUploadForm(form, upload))
+	}()
+
+	req, err := s.Client.NewRequest(http.MethodPost, fmt.Sprintf("%s?repository=%s", componentAPIEndpoint, url.QueryEscape(upload.Repository)), pipeReader)
+	if err != nil {
+		pipeReader.Close()
+		return err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	body, resp, err := s.Client.Do(req.WithContext(ctx))
+	pipeReader.Close()
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not upload component to repository '%s': HTTP: %d, %s", upload.Repository, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func writeComponentUploadForm(form *multipart.Writer, upload ComponentUpload) error {
+	for name, value := range upload.Fields {
+		if err := form.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+	for name, path := range upload.Files {
+		if err := writeComponentUploadFile(form, name, path); err != nil {
+			return err
+		}
+	}
+	return form.Close()
+}
+
+func writeComponentUploadFile(form *multipart.Writer, name string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	part, err := form.CreateFormFile(name, filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, file)
+	return err
+}
+
+// Get returns the component with the given id or nil if it does not exist
+func (s *ComponentService) Get(id string) (*Component, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s/%s", componentAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read component '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+
+	var component Component
+	if err := json.Unmarshal(body, &component); err != nil {
+		return nil, fmt.Errorf("could not unmarshal component: %v", err)
+	}
+	return &component, nil
+}
+
+func (s *ComponentService) Delete(id string) error {
+	body, resp, err := s.Client.Delete(fmt.Sprintf("%s/%s", componentAPIEndpoint, url.PathEscape(id)))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("could not delete component '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Search returns one page of the components matching query. The returned
+// continuation token is empty for the last page.
+func (s *ComponentService) Search(query url.Values) ([]Component, string, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s?%s", searchAPIEndpoint, query.Encode()), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("could not search components: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var result componentList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, "", fmt.Errorf("could not unmarshal components: %v", err)
+	}
+
+	continuationToken := ""
+	if result.ContinuationToken != nil {
+		continuationToken = *result.ContinuationToken
+	}
+	return result.Items, continuationToken, nil
+}
+
+// SearchAll returns all components matching query, following the
+// continuation tokens of the search
+func (s *ComponentService) SearchAll(query url.Values) ([]Component, error) {
+	var components []Component
+	for {
+		page, continuationToken, err := s.Search(query)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, page...)
+		if continuationToken == "" {
+			return components, nil
+		}
+		query = cloneQuery(query)
+		query.Set("continuationToken", continuationToken)
+	}
+}
+
+// SearchAssets returns one page of the assets matching query. The returned
+// continuation token is empty for the last page.
+func (s *ComponentService) SearchAssets(query url.Values) ([]Asset, string, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s?%s", searchAssetAPIEndpoint, query.Encode()), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("could not search assets: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var result assetList
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, "", fmt.Errorf("could not unmarshal assets: %v", err)
+	}
+
+	continuationToken := ""
+	if result.ContinuationToken != nil {
+		continuationToken = *result.ContinuationToken
+	}
+	return result.Items, continuationToken, nil
+}
+
+// SearchAssetsAll returns all assets matching query, following the
+// continuation tokens of the search
+func (s *ComponentService) SearchAssetsAll(query url.Values) ([]Asset, error) {
+	var assets []Asset
+	for {
+		page, continuationToken, err := s.SearchAssets(query)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, page...)
+		if continuationToken == "" {
+			return assets, nil
+		}
+		query = cloneQuery(query)
+		query.Set("continuationToken", continuationToken)
+	}
+}
+
+func cloneQuery(query url.Values) url.Values {
+	clone := make(url.Values, len(query))
+	for key, values := range query {
+		clone[key] = append([]string(nil), values...)
+	}
+	return clone
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponentUpload(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "script.sh")
+	assert.NoError(t, os.WriteFile(source, []byte("echo hello"), 0600))
+
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/"+componentAPIEndpoint, r.URL.Path)
+		assert.Equal(t, "raw-hosted", r.URL.Query().Get("repository"))
+
+		assert.NoError(t, r.ParseMultipartForm(1024))
+		assert.Equal(t, "scripts", r.FormValue("raw.directory"))
+		assert.Equal(t, "hello.sh", r.FormValue("raw.asset1.filename"))
+
+		file, header, err := r.FormFile("raw.asset1")
+		assert.NoError(t, err)
+		assert.Equal(t, "script.sh", header.Filename)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "echo hello", string(content))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := c.Component.Upload(context.Background(), ComponentUpload{
+		Repository: "raw-hosted",
+		Fields: map[string]string{
+			"raw.directory":       "scripts",
+			"raw.asset1.filename": "hello.sh",
+		},
+		Files: map[string]string{
+			"raw.asset1": source,
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestComponentUploadMissingFile(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	err := c.Component.Upload(context.Background(), ComponentUpload{
+		Repository: "raw-hosted",
+		Files: map[string]string{
+			"raw.asset1": filepath.Join(t.TempDir(), "missing"),
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestComponentUploadDeadline(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "script.sh")
+	assert.NoError(t, os.WriteFile(source, []byte("echo hello"), 0600))
+
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := c.Component.Upload(ctx, ComponentUpload{
+		Repository: "raw-hosted",
+		Files: map[string]string{
+			"raw.asset1": source,
+		},
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestComponentSearchAssetsAll(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+searchAssetAPIEndpoint, r.URL.Path)
+		assert.Equal(t, "maven-releases", r.URL.Query().Get("repository"))
+
+		switch r.URL.Query().Get("continuationToken") {
+		case "":
+			w.Write([]byte(`{"items":[{"id":"1","path":"a.jar"}],"continuationToken":"page2"}`))
+		case "page2":
+			w.Write([]byte(`{"items":[{"id":"2","path":"b.jar"}],"continuationToken":null}`))
+		default:
+			t.Errorf("unexpected continuation token %s", r.URL.Query().Get("continuationToken"))
+		}
+	})
+
+	query := url.Values{}
+	query.Set("repository", "maven-releases")
+
+	assets, err := c.Component.SearchAssetsAll(query)
+	assert.NoError(t, err)
+	assert.Len(t, assets, 2)
+	assert.Equal(t, "b.jar", assets[1].Path)
+	assert.Empty(t, query.Get("continuationToken"))
+}
@@ -0,0 +1,287 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	DockerMediaTypeManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	DockerMediaTypeManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	OCIMediaTypeManifest        = "application/vnd.oci.image.manifest.v1+json"
+	OCIMediaTypeIndex           = "application/vnd.oci.image.index.v1+json"
+
+	dockerContentDigestHeader = "Docker-Content-Digest"
+)
+
+var dockerManifestMediaTypes = []string{
+	DockerMediaTypeManifestList,
+	OCIMediaTypeIndex,
+	DockerMediaTypeManifest,
+	OCIMediaTypeManifest,
+}
+
+type DockerManifest struct {
+	// The content digest of the manifest, e.g. sha256:...
+	Digest string `json:"-"`
+	// The media type of the manifest
+	MediaType string `json:"mediaType"`
+	// The manifests of the platforms, only set for manifest lists and image indexes
+	Manifests []DockerPlatformManifest `json:"manifests,omitempty"`
+}
+
+// IsList returns whether the manifest is a manifest list or image index
+func (m *DockerManifest) IsList() bool {
+	return m.MediaType == DockerMediaTypeManifestList || m.MediaType == OCIMediaTypeIndex
+}
+
+type DockerPlatformManifest struct {
+	Digest    string         `json:"digest"`
+	MediaType string         `json:"mediaType"`
+	Size      int64          `json:"size"`
+	Platform  DockerPlatform `json:"platform"`
+}
+
+type DockerPlatform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+type dockerTagList struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+type dockerToken struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+type DockerService Service
+
+// RegistryURL returns the base URL of the Docker Registry v2 API of a docker
+// repository. If port is 0 the path based endpoint of the repository is
+// used, otherwise the repository connector listening on port.
+func (s *DockerService) RegistryURL(repository string, port int, https bool) (string, error) {
+	if port == 0 {
+		return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(s.Client.config.URL, "/"), repositoryContentPath, repository), nil
+	}
+
+	nexusURL, err := url.Parse(s.Client.config.URL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse nexus url: %v", err)
+	}
+	scheme := "http"
+	if https {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(nexusURL.Hostname(), strconv.Itoa(port))), nil
+}
+
+// GetManifest reads the manifest of image with the given tag or digest from
+// the registry at registryURL. It returns nil if the manifest does not exist.
+func (s *DockerService) GetManifest(registryURL string, image string, reference string) (*DockerManifest, error) {
+	body, resp, err := s.get(fmt.Sprintf("%s/v2/%s/manifests/%s", strings.TrimSuffix(registryURL, "/"), image, reference), strings.Join(dockerManifestMediaTypes, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read docker manifest of '%s:%s': HTTP: %d, %s", image, reference, resp.StatusCode, string(body))
+	}
+
+	var manifest DockerManifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("could not unmarshal docker manifest: %v", err)
+	}
+	if manifest.MediaType == "" {
+		manifest.MediaType, _, _ = strings.Cut(resp.Header.Get("Content-Type"), ";")
+	}
+
+	manifest.Digest = resp.Header.Get(dockerContentDigestHeader)
+	if manifest.Digest == "" {
+		sum := sha256.Sum256(body)
+		manifest.Digest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	return &manifest, nil
+}
+
+// ListTags returns all tags of image in the registry at registryURL
+func (s *DockerService) ListTags(registryURL string, image string) ([]string, error) {
+	base, err := url.Parse(strings.TrimSuffix(registryURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse registry url: %v", err)
+	}
+
+	var tags []string
+	next := fmt.Sprintf("%s/v2/%s/tags/list", base.String(), image)
+	for next != "" {
+		body, resp, err := s.get(next, "application/json")
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not list docker tags of '%s': HTTP: %d, %s", image, resp.StatusCode, string(body))
+		}
+
+		var tagList dockerTagList
+		if err := json.Unmarshal(body, &tagList); err != nil {
+			return nil, fmt.Errorf("could not unmarshal docker tags: %v", err)
+		}
+		tags = append(tags, tagList.Tags...)
+
+		next, err = getDockerNextLink(base, resp.Header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
+// get sends a GET request to the registry. If the registry challenges the
+// request, it is repeated with basic auth or a bearer token obtained from
+// the token realm, both with the credentials of the provider.
+func (s *DockerService) get(target string, accept string) ([]byte, *http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", accept)
+
+	body, resp, err := s.Client.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return body, resp, err
+	}
+
+	scheme, params := parseWWWAuthenticate(resp.Header.Get("WWW-Authenticate"))
+	switch strings.ToLower(scheme) {
+	case "bearer":
+		token, err := s.getToken(params)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case "basic":
+		req.SetBasicAuth(s.Client.config.Username, s.Client.config.Password)
+	default:
+		return body, resp, nil
+	}
+	return s.Client.Do(req)
+}
+
+func (s *DockerService) getToken(params map[string]string) (string, error) {
+	realm, ok := params["realm"]
+	if !ok {
+		return "", fmt.Errorf("docker registry did not send a token realm")
+	}
+	realmURL, err := url.Parse(realm)
+	if err != nil {
+		return "", fmt.Errorf("could not parse docker token realm: %v", err)
+	}
+
+	query := realmURL.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := params[key]; ok {
+			query.Set(key, value)
+		}
+	}
+	realmURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realmURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(s.Client.config.Username, s.Client.config.Password)
+	req.Header.Set("Accept", "application/json")
+
+	body, resp, err := s.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not get docker token: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var token dockerToken
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("could not unmarshal docker token: %v", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("docker token realm did not return a token")
+}
+
+// parseWWWAuthenticate splits a challenge like
+// `Bearer realm="https://nexus/v2/token",service="nexus",scope="..."`
+// into its scheme and parameters
+func parseWWWAuthenticate(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = strings.TrimPrefix(strings.TrimSpace(value[end+2:]), ",")
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+			params[key] = strings.TrimSpace(params[key])
+		}
+	}
+	return scheme, params
+}
+
+// getDockerNextLink returns the target of a `Link: <...>; rel="next"` header
+// resolved against base, or an empty string if there is none
+func getDockerNextLink(base *url.URL, header string) (string, error) {
+	if header == "" || !strings.Contains(header, `rel="next"`) {
+		return "", nil
+	}
+	start := strings.Index(header, "<")
+	end := strings.Index(header, ">")
+	if start < 0 || end < start {
+		return "", nil
+	}
+
+	link, err := url.Parse(header[start+1 : end])
+	if err != nil {
+		return "", fmt.Errorf("could not parse docker tags link: %v", err)
+	}
+	if link.IsAbs() {
+		return link.String(), nil
+	}
+	// relative links are absolute paths on the registry host, which may be
+	// below a path prefix for path based repository endpoints
+	next := *base
+	next.Path = link.Path
+	if prefix := strings.TrimSuffix(base.Path, "/"); prefix != "" && !strings.HasPrefix(link.Path, prefix+"/") {
+		next.Path = prefix + link.Path
+	}
+	next.RawQuery = link.RawQuery
+	return next.String(), nil
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDockerGetManifestWithBearerToken(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/token":
+			username, password, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "admin", username)
+			assert.Equal(t, "admin123", password)
+			assert.Equal(t, "nexus", r.URL.Query().Get("service"))
+			assert.Equal(t, "repository:library/alpine:pull", r.URL.Query().Get("scope"))
+			w.Write([]byte(`{"token":"secret-token"}`))
+		case "/repository/docker-hosted/v2/library/alpine/manifests/3.19":
+			if r.Header.Get("Authorization") != "Bearer secret-token" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/v2/token",service="nexus",scope="repository:library/alpine:pull"`, r.Host))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Contains(t, r.Header.Get("Accept"), DockerMediaTypeManifestList)
+			w.Header().Set("Content-Type", DockerMediaTypeManifestList)
+			w.Header().Set(dockerContentDigestHeader, "sha256:1234")
+			w.Write([]byte(`{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+  "manifests": [
+    {"digest": "sha256:aaaa", "mediaType": "application/vnd.docker.distribution.manifest.v2+json", "size": 528, "platform": {"architecture": "amd64", "os": "linux"}},
+    {"digest": "sha256:bbbb", "mediaType": "application/vnd.docker.distribution.manifest.v2+json", "size": 528, "platform": {"architecture": "arm", "os": "linux", "variant": "v7"}}
+  ]
+}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	registryURL, err := c.Docker.RegistryURL("docker-hosted", 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, c.config.URL+"/repository/docker-hosted", registryURL)
+
+	manifest, err := c.Docker.GetManifest(registryURL, "library/alpine", "3.19")
+	assert.NoError(t, err)
+	assert.Equal(t, "sha256:1234", manifest.Digest)
+	assert.Equal(t, DockerMediaTypeManifestList, manifest.MediaType)
+	assert.True(t, manifest.IsList())
+	assert.Len(t, manifest.Manifests, 2)
+	assert.Equal(t, DockerPlatform{Architecture: "arm", OS: "linux", Variant: "v7"}, manifest.Manifests[1].Platform)
+
+	manifest, err = c.Docker.GetManifest(registryURL, "library/alpine", "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, manifest)
+}
+
+func TestDockerListTagsWithBasicAuth(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); !ok {
+			w.Header().Set("WWW-Authenticate", `Basic realm="Sonatype Nexus Repository Manager"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "/repository/docker-hosted/v2/app/tags/list", r.URL.Path)
+		if r.URL.Query().Get("last") == "" {
+			w.Header().Set("Link", `</v2/app/tags/list?n=2&last=1.1>; rel="next"`)
+			w.Write([]byte(`{"name":"app","tags":["1.0","1.1"]}`))
+			return
+		}
+		w.Write([]byte(`{"name":"app","tags":["latest"]}`))
+	})
+
+	registryURL, err := c.Docker.RegistryURL("docker-hosted", 0, false)
+	assert.NoError(t, err)
+
+	tags, err := c.Docker.ListTags(registryURL, "app")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.0", "1.1", "latest"}, tags)
+}
+
+func TestDockerRegistryURLWithConnector(t *testing.T) {
+	c := NewClient(client.Config{URL: "http://nexus.example.com:8081/"})
+
+	registryURL, err := c.Docker.RegistryURL("docker-hosted", 8082, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://nexus.example.com:8082", registryURL)
+
+	registryURL, err = c.Docker.RegistryURL("docker-hosted", 8443, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://nexus.example.com:8443", registryURL)
+}
+
+func TestParseWWWAuthenticate(t *testing.T) {
+	scheme, params := parseWWWAuthenticate(`Bearer realm="https://nexus/v2/token",service="nexus, docker",scope=repository:app:pull`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://nexus/v2/token",
+		"service": "nexus, docker",
+		"scope":   "repository:app:pull",
+	}, params)
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	httpSettingsScriptName = "terraform-provider-nexus-http-settings"
+	httpSettingsScript     = `
+import groovy.json.JsonOutput
+import groovy.json.JsonSlurper
+import org.sonatype.goodies.common.Time
+import org.sonatype.nexus.httpclient.HttpClientManager
+import org.sonatype.nexus.httpclient.config.ConnectionConfiguration
+import org.sonatype.nexus.httpclient.config.NtlmAuthenticationConfiguration
+import org.sonatype.nexus.httpclient.config.ProxyConfiguration
+import org.sonatype.nexus.httpclient.config.ProxyServerConfiguration
+import org.sonatype.nexus.httpclient.config.UsernameAuthenticationConfiguration
+
+def manager = container.lookup(HttpClientManager.class.getName())
+def parsedArgs = new JsonSlurper().parseText(args)
+
+if (parsedArgs.action == 'update') {
+    def settings = parsedArgs.settings
+    def configuration = manager.newConfiguration()
+
+    def connection = new ConnectionConfiguration()
+    if (settings.timeout != null) {
+        connection.setTimeout(Time.seconds(settings.timeout))
+    }
+    connection.setMaximumRetries(settings.retries)
+    connection.setUserAgentSuffix(settings.userAgentSuffix)
+    configuration.setConnection(connection)
+
+    if (settings.httpProxy != null) {
+        def proxy = new ProxyConfiguration()
+        proxy.setHttp(toProxyServer(settings.httpProxy))
+        if (settings.httpsProxy != null) {
+            proxy.setHttps(toProxyServer(settings.httpsProxy))
+        }
+        proxy.setNonProxyHosts((settings.nonProxyHosts ?: []) as String[])
+        configuration.setProxy(proxy)
+    }
+
+    manager.setConfiguration(configuration)
+}
+
+def configuration = manager.getConfiguration()
+return JsonOutput.toJson([
+    userAgentSuffix: configuration.connection?.userAgentSuffix,
+    timeout        : configuration.connection?.timeout?.toSecondsI(),
+    retries        : configuration.connection?.maximumRetries,
+    httpProxy      : fromProxyServer(configuration.proxy?.http),
+    httpsProxy     : fromProxyServer(configuration.proxy?.https),
+    nonProxyHosts  : configuration.proxy?.nonProxyHosts ?: [],
+])
+
+def toProxyServer(server) {
+    def proxyServer = new ProxyServerConfiguration()
+    proxyServer.setEnabled(true)
+    proxyServer.setHost(server.host)
+    proxyServer.setPort(server.port)
+    if (server.authentication != null) {
+        proxyServer.setAuthentication(toAuthentication(server.authentication))
+    }
+    return proxyServer
+}
+
+def toAuthentication(authentication) {
+    if (authentication.type == 'ntlm') {
+        def ntlm = new NtlmAuthenticationConfiguration()
+        ntlm.setUsername(authentication.username)
+        ntlm.setPassword(authentication.password)
+        ntlm.setDomain(authentication.ntlmDomain)
+        ntlm.setHost(authentication.ntlmHost)
+        return ntlm
+    }
+    def username = new UsernameAuthenticationConfiguration()
+    username.setUsername(authentication.username)
+    username.setPassword(authentication.password)
+    return username
+}
+
+def fromProxyServer(server) {
+    if (server == null || !server.enabled) {
+        return null
+    }
+    return [
+        host          : server.host,
+        port          : server.port,
+        authentication: fromAuthentication(server.authentication),
+    ]
+}
+
+def fromAuthentication(authentication) {
+    if (authentication instanceof NtlmAuthenticationConfiguration) {
+        return [type: 'ntlm', username: authentication.username, ntlmDomain: authentication.domain, ntlmHost: authentication.host]
+    }
+    if (authentication instanceof UsernameAuthenticationConfiguration) {
+        return [type: 'username', username: authentication.username]
+    }
+    return null
+}
+`
+)
+
+// HTTPSettings are the global settings of the HTTP client nexus uses for all
+// outbound connections, e.g. of proxy repositories
+type HTTPSettings struct {
+	// Custom fragment to append to User-Agent header in HTTP requests
+	UserAgentSuffix string `json:"userAgentSuffix,omitempty"`
+	// Seconds to wait for activity before stopping and retrying the connection
+	Timeout *int `json:"timeout,omitempty"`
+	// Total retries if the initial connection attempt suffers a timeout
+	Retries *int `json:"retries,omitempty"`
+	// Proxy used for HTTP requests
+	HTTPProxy *HTTPSettingsProxy `json:"httpProxy,omitempty"`
+	// Proxy used for HTTPS requests, requires HTTPProxy to be set
+	HTTPSProxy *HTTPSettingsProxy `json:"httpsProxy,omitempty"`
+	// Hosts which are connected to without proxy
+	NonProxyHosts []string `json:"nonProxyHosts,omitempty"`
+}
+
+type HTTPSettingsProxy struct {
+	Host           string                               `json:"host"`
+	Port           int                                  `json:"port"`
+	Authentication *repository.HTTPClientAuthentication `json:"authentication,omitempty"`
+}
+
+type httpSettingsArgs struct {
+	Action   string        `json:"action"`
+	Settings *HTTPSettings `json:"settings,omitempty"`
+}
+
+type HTTPSettingsService Service
+
+func (s *HTTPSettingsService) script() schema.Script {
+	return schema.Script{
+		Name:    httpSettingsScriptName,
+		Content: httpSettingsScript,
+		Type:    "groovy",
+	}
+}
+
+// Get returns the settings, it returns ErrScriptNotStored until the script is
+// stored by Update
+func (s *HTTPSettingsService) Get() (*HTTPSettings, error) {
+	var settings HTTPSettings
+	if err := s.Client.runStoredScript(s.script(), httpSettingsArgs{Action: "read"}, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (s *HTTPSettingsService) Update(settings *HTTPSettings) error {
+	return s.Client.runScript(s.script(), httpSettingsArgs{Action: "update", Settings: settings}, nil)
+}
+
+// Reset restores the default settings without proxies
+func (s *HTTPSettingsService) Reset() error {
+	return s.Update(&HTTPSettings{})
+}
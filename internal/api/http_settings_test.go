@@ -0,0 +1,56 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPSettingsGet(t *testing.T) {
+	var requests []string
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]string{"name": httpSettingsScriptName, "content": httpSettingsScript, "type": "groovy"})
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"action":"read"}`, string(body))
+
+			result, _ := json.Marshal(scriptResult{
+				Name:   httpSettingsScriptName,
+				Result: `{"timeout":20,"retries":2,"httpProxy":{"host":"proxy","port":3128,"authentication":{"type":"username","username":"user"}},"nonProxyHosts":["localhost"]}`,
+			})
+			w.Write(result)
+		}
+	})
+
+	settings, err := c.HTTPSettings.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"GET /" + scriptAPIEndpoint + "/" + httpSettingsScriptName,
+		"POST /" + scriptAPIEndpoint + "/" + httpSettingsScriptName + "/run",
+	}, requests)
+	assert.Equal(t, 20, *settings.Timeout)
+	assert.Equal(t, 2, *settings.Retries)
+	assert.Equal(t, "proxy", settings.HTTPProxy.Host)
+	assert.Equal(t, 3128, settings.HTTPProxy.Port)
+	assert.Equal(t, "user", settings.HTTPProxy.Authentication.Username)
+	assert.Nil(t, settings.HTTPSProxy)
+	assert.Equal(t, []string{"localhost"}, settings.NonProxyHosts)
+}
+
+func TestHTTPSettingsGetNotStored(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// reads never store the script
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := c.HTTPSettings.Get()
+	assert.ErrorIs(t, err, ErrScriptNotStored)
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	iqServerAPIEndpoint = basePath + "v1/iq"
+
+	IQServerAuthenticationTypeUser = "USER"
+	IQServerAuthenticationTypePKI  = "PKI"
+)
+
+type IQServer struct {
+	// Whether the connection to the IQ server is enabled
+	Enabled bool `json:"enabled"`
+	// Show the link to the IQ server dashboard in the nexus user interface
+	ShowLink bool `json:"showLink"`
+	// The URL of the IQ server
+	URL string `json:"url,omitempty"`
+	// The authentication method, either USER or PKI
+	AuthenticationType string `json:"authenticationType"`
+	// The username used to authenticate against the IQ server
+	Username string `json:"username,omitempty"`
+	// The password used to authenticate against the IQ server
+	Password string `json:"password,omitempty"`
+	// Use certificates stored in the nexus truststore to connect to the IQ server
+	UseTrustStoreForURL bool `json:"useTrustStoreForUrl"`
+	// Seconds to wait for activity before stopping and retrying the connection
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+	// Additional properties of the IQ server connection, one key=value pair per line
+	Properties string `json:"properties,omitempty"`
+}
+
+type IQServerService Service
+
+func (s *IQServerService) Get() (*IQServer, error) {
+	body, resp, err := s.Client.Get(iqServerAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read iq server connection: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var iqServer IQServer
+	if err := json.Unmarshal(body, &iqServer); err != nil {
+		return nil, fmt.Errorf("could not unmarshal iq server connection: %v", err)
+	}
+	return &iqServer, nil
+}
+
+func (s *IQServerService) Update(iqServer *IQServer) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(iqServer)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Put(iqServerAPIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update iq server connection: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Disable disables the connection to the IQ server but keeps its configuration
+func (s *IQServerService) Disable() error {
+	body, resp, err := s.Client.Post(fmt.Sprintf("%s/disable", iqServerAPIEndpoint), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not disable iq server connection: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
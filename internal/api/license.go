@@ -0,0 +1,112 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	licenseAPIEndpoint = basePath + "v1/system/license"
+)
+
+type License struct {
+	// The email address of the license contact
+	ContactEmail string `json:"contactEmail"`
+	// The company the license is issued to
+	ContactCompany string `json:"contactCompany"`
+	// The name of the license contact
+	ContactName string `json:"contactName"`
+	// The date the license becomes valid
+	EffectiveDate string `json:"effectiveDate"`
+	// The date the license expires
+	ExpirationDate string `json:"expirationDate"`
+	// The type of the license, e.g. PRODUCT-LICENSE
+	LicenseType string `json:"licenseType"`
+	// The number of licensed users
+	LicensedUsers string `json:"licensedUsers"`
+	// The fingerprint identifying the license
+	Fingerprint string `json:"fingerprint"`
+	// The licensed features, comma separated
+	Features string `json:"features"`
+}
+
+type LicenseService Service
+
+// Get returns the installed license or nil if no license is installed
+func (s *LicenseService) Get() (*License, error) {
+	body, resp, err := s.Client.Get(licenseAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// nexus responds with 402 Payment Required if no license is installed
+	if resp.StatusCode == http.StatusPaymentRequired {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read license: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var license License
+	if err := json.Unmarshal(body, &license); err != nil {
+		return nil, fmt.Errorf("could not unmarshal license: %v", err)
+	}
+	return &license, nil
+}
+
+// IsInstalled reports whether a license is installed, which is required for
+// the features of nexus pro. It only reads the license, nexus without the
+// pro features responds with 404 Not Found.
+func (s *LicenseService) IsInstalled() (bool, error) {
+	body, resp, err := s.Client.Get(licenseAPIEndpoint, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound, http.StatusPaymentRequired:
+		return false, nil
+	default:
+		return false, fmt.Errorf("could not read license: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+}
+
+// Install uploads the binary content of a license file
+func (s *LicenseService) Install(content []byte) (*License, error) {
+	req, err := s.Client.NewRequest(http.MethodPost, licenseAPIEndpoint, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	body, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not install license: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var license License
+	if err := json.Unmarshal(body, &license); err != nil {
+		return nil, fmt.Errorf("could not unmarshal license: %v", err)
+	}
+	return &license, nil
+}
+
+func (s *LicenseService) Delete() error {
+	body, resp, err := s.Client.Delete(licenseAPIEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not delete license: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLicenseInstall(t *testing.T) {
+	content := []byte{0xca, 0xfe, 0xba, 0xbe}
+
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/"+licenseAPIEndpoint, r.URL.Path)
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, content, body)
+
+		w.Write([]byte(`{"contactCompany":"Example Inc","expirationDate":"2030-01-01T00:00:00.000+0000","features":"SonatypeCLM, Firewall"}`))
+	})
+
+	license, err := c.License.Install(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "Example Inc", license.ContactCompany)
+	assert.Equal(t, "SonatypeCLM, Firewall", license.Features)
+}
+
+func TestLicenseGetNotInstalled(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPaymentRequired)
+	})
+
+	license, err := c.License.Get()
+	assert.NoError(t, err)
+	assert.Nil(t, license)
+}
+
+func TestLicenseIsInstalled(t *testing.T) {
+	for status, installed := range map[int]bool{
+		http.StatusOK:              true,
+		http.StatusPaymentRequired: false,
+		http.StatusNotFound:        false,
+	} {
+		c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/"+licenseAPIEndpoint, r.URL.Path)
+			w.WriteHeader(status)
+		})
+
+		actual, err := c.License.IsInstalled()
+		assert.NoError(t, err)
+		assert.Equal(t, installed, actual, status)
+	}
+
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	_, err := c.License.IsInstalled()
+	assert.EqualError(t, err, "could not read license: HTTP: 403, ")
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	mailConfigAPIEndpoint = basePath + "v1/email"
+)
+
+type MailConfig struct {
+	// Whether sending emails is enabled
+	Enabled bool `json:"enabled"`
+	// The host of the SMTP server
+	Host string `json:"host"`
+	// The port of the SMTP server
+	Port int `json:"port"`
+	// The username used to authenticate against the SMTP server
+	Username string `json:"username,omitempty"`
+	// The password used to authenticate against the SMTP server
+	Password string `json:"password,omitempty"`
+	// The address used as sender of all emails
+	FromAddress string `json:"fromAddress"`
+	// A prefix added to the subject of all emails
+	SubjectPrefix string `json:"subjectPrefix,omitempty"`
+	// Enable STARTTLS support for insecure connections
+	StartTLSEnabled bool `json:"startTlsEnabled"`
+	// Require STARTTLS support
+	StartTLSRequired bool `json:"startTlsRequired"`
+	// Enable SSL/TLS encryption upon connection
+	SSLOnConnectEnabled bool `json:"sslOnConnectEnabled"`
+	// Verify the server certificate when using TLS or SSL
+	SSLServerIdentityCheckEnabled bool `json:"sslServerIdentityCheckEnabled"`
+	// Use the Nexus truststore
+	NexusTrustStoreEnabled bool `json:"nexusTrustStoreEnabled"`
+}
+
+type MailConfigVerification struct {
+	Success bool   `json:"success"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type MailConfigService Service
+
+func (s *MailConfigService) Get() (*MailConfig, error) {
+	body, resp, err := s.Client.Get(mailConfigAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read mail config: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var config MailConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal mail config: %v", err)
+	}
+	return &config, nil
+}
+
+func (s *MailConfigService) Update(config *MailConfig) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(config)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Put(mailConfigAPIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update mail config: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *MailConfigService) Delete() error {
+	body, resp, err := s.Client.Delete(mailConfigAPIEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not delete mail config: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Verify sends a test email to recipient using the stored mail config
+func (s *MailConfigService) Verify(recipient string) (*MailConfigVerification, error) {
+	body, resp, err := s.Client.Post(fmt.Sprintf("%s/verify", mailConfigAPIEndpoint), strings.NewReader(recipient))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not verify mail config: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var verification MailConfigVerification
+	if err := json.Unmarshal(body, &verification); err != nil {
+		return nil, fmt.Errorf("could not unmarshal mail config verification: %v", err)
+	}
+	return &verification, nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(client.Config{
+		URL:      server.URL,
+		Username: "admin",
+		Password: "admin123",
+	})
+}
+
+func TestMailConfigVerify(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/"+mailConfigAPIEndpoint+"/verify", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "admin@example.com", string(body))
+
+		w.Write([]byte(`{"success":false,"reason":"connection refused"}`))
+	})
+
+	verification, err := c.MailConfig.Verify("admin@example.com")
+	assert.NoError(t, err)
+	assert.False(t, verification.Success)
+	assert.Equal(t, "connection refused", verification.Reason)
+}
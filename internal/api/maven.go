@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	repositoryContentPath = "repository"
+	mavenMetadataFile     = "maven-metadata.xml"
+)
+
+type MavenMetadata struct {
+	GroupID    string                  `xml:"groupId"`
+	ArtifactID string                  `xml:"artifactId"`
+	Version    string                  `xml:"version"`
+	Versioning MavenMetadataVersioning `xml:"versioning"`
+}
+
+type MavenMetadataVersioning struct {
+	Latest           string                 `xml:"latest"`
+	Release          string                 `xml:"release"`
+	Versions         []string               `xml:"versions>version"`
+	LastUpdated      string                 `xml:"lastUpdated"`
+	Snapshot         *MavenMetadataSnapshot `xml:"snapshot"`
+	SnapshotVersions []MavenSnapshotVersion `xml:"snapshotVersions>snapshotVersion"`
+}
+
+type MavenMetadataSnapshot struct {
+	Timestamp   string `xml:"timestamp"`
+	BuildNumber int    `xml:"buildNumber"`
+}
+
+type MavenSnapshotVersion struct {
+	Classifier string `xml:"classifier"`
+	Extension  string `xml:"extension"`
+	Value      string `xml:"value"`
+	Updated    string `xml:"updated"`
+}
+
+type MavenService Service
+
+// ContentURL returns the URL of path in the given repository
+func (s *MavenService) ContentURL(repository string, path string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", strings.TrimSuffix(s.Client.config.URL, "/"), repositoryContentPath, repository, strings.TrimPrefix(path, "/"))
+}
+
+// GetMetadata reads the maven-metadata.xml of an artifact, if version is
+// empty, or of a snapshot version. It returns nil if the file does not exist.
+func (s *MavenService) GetMetadata(repository string, groupID string, artifactID string, version string) (*MavenMetadata, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", repositoryContentPath, repository, strings.ReplaceAll(groupID, ".", "/"), artifactID)
+	if version != "" {
+		path += "/" + version
+	}
+
+	req, err := s.Client.NewRequest(http.MethodGet, path+"/"+mavenMetadataFile, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/xml")
+
+	body, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read maven metadata of '%s:%s' in repository '%s': HTTP: %d, %s", groupID, artifactID, repository, resp.StatusCode, string(body))
+	}
+
+	var metadata MavenMetadata
+	if err := xml.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("could not unmarshal maven metadata: %v", err)
+	}
+	return &metadata, nil
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMavenGetMetadata(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repository/maven-releases/com/example/service/maven-metadata.xml":
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<metadata>
+  <groupId>com.example</groupId>
+  <artifactId>service</artifactId>
+  <versioning>
+    <latest>1.1.0</latest>
+    <release>1.1.0</release>
+    <versions>
+      <version>1.0.0</version>
+      <version>1.1.0</version>
+    </versions>
+    <lastUpdated>20240102030405</lastUpdated>
+  </versioning>
+</metadata>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	metadata, err := c.Maven.GetMetadata("maven-releases", "com.example", "service", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0", metadata.Versioning.Release)
+	assert.Equal(t, []string{"1.0.0", "1.1.0"}, metadata.Versioning.Versions)
+
+	metadata, err = c.Maven.GetMetadata("maven-releases", "com.example", "missing", "")
+	assert.NoError(t, err)
+	assert.Nil(t, metadata)
+
+	assert.Equal(t, c.config.URL+"/repository/maven-releases/com/example/service/1.1.0/service-1.1.0.jar", c.Maven.ContentURL("maven-releases", "com/example/service/1.1.0/service-1.1.0.jar"))
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type RawFile struct {
+	// The content of the file
+	Content []byte
+	// The content type nexus serves the file with
+	ContentType string
+	// When the file was last modified, zero if nexus did not send it
+	LastModified time.Time
+}
+
+// ErrRawFileTooLarge is returned by RawService.Download for files exceeding the size limit
+type ErrRawFileTooLarge struct {
+	Path    string
+	MaxSize int64
+}
+
+func (e *ErrRawFileTooLarge) Error() string {
+	return fmt.Sprintf("file %s is larger than %d bytes", e.Path, e.MaxSize)
+}
+
+type RawService Service
+
+// Download reads the file at path of a raw repository. Files larger than
+// maxSize bytes are not read completely but fail with *ErrRawFileTooLarge.
+// It returns nil if the file does not exist. Like uploads, it is limited by
+// the deadline of ctx instead of the default request timeout.
+func (s *RawService) Download(ctx context.Context, repository string, path string, maxSize int64) (*RawFile, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	req, err := s.Client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", repositoryContentPath, url.PathEscape(repository), strings.Join(segments, "/")), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "*/*")
+	req.Header.Del("Content-Type")
+
+	req, cancel := withRequestTimeout(req.WithContext(ctx))
+	defer cancel()
+
+	resp, err := s.Client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("could not download '%s' from repository '%s': HTTP: %d, %s", path, repository, resp.StatusCode, string(body))
+	}
+
+	if resp.ContentLength > maxSize {
+		return nil, &ErrRawFileTooLarge{Path: path, MaxSize: maxSize}
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, &ErrRawFileTooLarge{Path: path, MaxSize: maxSize}
+	}
+
+	file := RawFile{
+		Content:     content,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if lastModified := resp.Header.Get("Last-Modified"); lastModified != "" {
+		if file.LastModified, err = http.ParseTime(lastModified); err != nil {
+			return nil, fmt.Errorf("could not parse last modified date %q: %v", lastModified, err)
+		}
+	}
+	return &file, nil
+}
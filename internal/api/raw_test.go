@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawDownload(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "admin123", password)
+
+		switch r.URL.EscapedPath() {
+		case "/repository/raw-config/flags/feature%20flags.json":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Last-Modified", "Tue, 02 Jan 2024 03:04:05 GMT")
+			w.Write([]byte(`{"enabled":true}`))
+		case "/repository/raw-config/large.bin":
+			w.Write(make([]byte, 64))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	file, err := c.Raw.Download(context.Background(), "raw-config", "/flags/feature flags.json", 32)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"enabled":true}`, string(file.Content))
+	assert.Equal(t, "application/json", file.ContentType)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), file.LastModified)
+
+	_, err = c.Raw.Download(context.Background(), "raw-config", "large.bin", 32)
+	var tooLarge *ErrRawFileTooLarge
+	assert.True(t, errors.As(err, &tooLarge))
+	assert.EqualError(t, err, "file large.bin is larger than 32 bytes")
+
+	file, err = c.Raw.Download(context.Background(), "raw-config", "missing.txt", 32)
+	assert.NoError(t, err)
+	assert.Nil(t, file)
+}
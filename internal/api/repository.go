@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	repositoryAPIEndpoint         = basePath + "v1/repositories"
+	repositorySettingsAPIEndpoint = basePath + "v1/repositorySettings"
+
+	// RepositoryMoveTaskType is the type of the nexus pro task changing the
+	// blobstore of a repository
+	RepositoryMoveTaskType = "repository.move"
+)
+
+// RepositorySettings contains the attributes shared by the configurations
+// of all repository formats and types
+type RepositorySettings struct {
+	Name    string                     `json:"name"`
+	Format  string                     `json:"format"`
+	Type    string                     `json:"type"`
+	Storage *RepositorySettingsStorage `json:"storage,omitempty"`
+}
+
+type RepositorySettingsStorage struct {
+	// The blobstore the repository stores its blobs in
+	BlobStoreName string `json:"blobStoreName"`
+}
+
+type RepositoryService Service
+
+// List returns the name, format, type and URL of all repositories, see
+// Client.EnableListCache
+func (s *RepositoryService) List() ([]repository.RepositoryInfo, error) {
+	body, err := s.Client.getList(ListCacheRepositories, repositoryAPIEndpoint, "repositories")
+	if err != nil {
+		return nil, err
+	}
+
+	var repositoryInfos []repository.RepositoryInfo
+	if err := json.Unmarshal(body, &repositoryInfos); err != nil {
+		return nil, fmt.Errorf("could not unmarshal list of repository infos: %v", err)
+	}
+	return repositoryInfos, nil
+}
+
+// ListSettings returns the configurations of all repositories, see
+// Client.EnableListCache
+func (s *RepositoryService) ListSettings() ([]RepositorySettings, error) {
+	body, err := s.Client.getList(ListCacheRepositories, repositorySettingsAPIEndpoint, "repository settings")
+	if err != nil {
+		return nil, err
+	}
+
+	var settings []RepositorySettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository settings: %v", err)
+	}
+	return settings, nil
+}
+
+// GetSettings unmarshals the configuration of the repository with the given
+// name, format and type from the settings of all repositories into repo,
+// which must be the type of the configuration, e.g.
+// *repository.DockerHostedRepository. It returns false if the repository
+// does not exist. With enabled list cache all repositories share one request,
+// see Client.EnableListCache.
+func (s *RepositoryService) GetSettings(name string, format string, repoType string, repo interface{}) (bool, error) {
+	body, err := s.Client.getList(ListCacheRepositories, repositorySettingsAPIEndpoint, "repository settings")
+	if err != nil {
+		return false, err
+	}
+
+	var configurations []json.RawMessage
+	if err := json.Unmarshal(body, &configurations); err != nil {
+		return false, fmt.Errorf("could not unmarshal repository settings: %v", err)
+	}
+	for _, configuration := range configurations {
+		var settings RepositorySettings
+		if err := json.Unmarshal(configuration, &settings); err != nil {
+			return false, fmt.Errorf("could not unmarshal repository settings: %v", err)
+		}
+		if settings.Name != name || settings.Format != format || settings.Type != repoType {
+			continue
+		}
+		if err := json.Unmarshal(configuration, repo); err != nil {
+			return false, fmt.Errorf("could not unmarshal repository %s: %v", name, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// ListByBlobstore returns the sorted names of the repositories storing their
+// blobs in the given blobstore
+func (s *RepositoryService) ListByBlobstore(blobstore string) ([]string, error) {
+	settings, err := s.ListSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, repo := range settings {
+		if repo.Storage != nil && repo.Storage.BlobStoreName == blobstore {
+			names = append(names, repo.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// MoveToBlobstore moves the blobs of a repository to another blobstore with
+// the nexus pro task and waits until it finished or the timeout expired, see
+// TaskService.RunOnce
+func (s *RepositoryService) MoveToBlobstore(ctx context.Context, name string, blobstore string, timeout time.Duration, progress func(*Task)) error {
+	_, err := s.Client.Task.RunOnce(ctx, &TaskConfiguration{
+		TypeID: RepositoryMoveTaskType,
+		Name:   fmt.Sprintf("Move repository %s to blobstore %s", name, blobstore),
+		Properties: map[string]string{
+			"moveRepositoryName":      name,
+			"moveTargetBlobStoreName": blobstore,
+		},
+	}, timeout, progress)
+	// the task changes the repository and the usage of both blobstores
+	s.Client.InvalidateListCache(ListCacheBlobstores, ListCacheRepositories)
+	return err
+}
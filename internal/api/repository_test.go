@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryListByBlobstore(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/"+repositorySettingsAPIEndpoint, r.URL.Path)
+		w.Write([]byte(`[
+			{"name":"raw-b","format":"raw","type":"hosted","storage":{"blobStoreName":"store"}},
+			{"name":"raw-a","format":"raw","type":"proxy","storage":{"blobStoreName":"store"}},
+			{"name":"npm","format":"npm","type":"hosted","storage":{"blobStoreName":"default"}},
+			{"name":"raw-group","format":"raw","type":"group"}
+		]`))
+	})
+
+	names, err := c.Repository.ListByBlobstore("store")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"raw-a", "raw-b"}, names)
+
+	names, err = c.Repository.ListByBlobstore("unused")
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+func TestRepositoryListSettingsError(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`forbidden`))
+	})
+
+	_, err := c.Repository.ListSettings()
+	assert.EqualError(t, err, "could not list repository settings: HTTP: 403, forbidden")
+}
+
+func TestRepositoryGetSettings(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/"+repositorySettingsAPIEndpoint, r.URL.Path)
+		w.Write([]byte(`[
+			{"name":"raw","format":"raw","type":"hosted","online":true,"storage":{"blobStoreName":"default","strictContentTypeValidation":true}},
+			{"name":"docker","format":"docker","type":"hosted","online":false,"docker":{"v1Enabled":false,"forceBasicAuth":true,"httpPort":8082}}
+		]`))
+	})
+
+	var docker repository.DockerHostedRepository
+	found, err := c.Repository.GetSettings("docker", "docker", "hosted", &docker)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "docker", docker.Name)
+	assert.False(t, docker.Online)
+	assert.True(t, docker.Docker.ForceBasicAuth)
+	assert.Equal(t, 8082, *docker.Docker.HTTPPort)
+
+	// the format and type must match
+	found, err = c.Repository.GetSettings("raw", "raw", "proxy", &repository.RawProxyRepository{})
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	found, err = c.Repository.GetSettings("unknown", "raw", "hosted", &repository.RawHostedRepository{})
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema"
+)
+
+const (
+	scriptAPIEndpoint = basePath + "v1/script"
+)
+
+// ErrScriptNotStored is returned by runStoredScript if the script is not
+// stored in nexus or stored in another version
+var ErrScriptNotStored = errors.New("the script is not stored in nexus in this version, it is stored by the next apply changing a resource using it")
+
+type scriptResult struct {
+	Name   string `json:"name"`
+	Result string `json:"result"`
+}
+
+// runScript stores script in nexus, creating or replacing it as required,
+// runs it with args marshalled to JSON and unmarshals its result into v.
+// It is used for settings which are not exposed by the REST API. Only
+// changes store the script, reads use runStoredScript.
+func (c *Client) runScript(script schema.Script, args interface{}, v interface{}) error {
+	if err := c.storeScript(script); err != nil {
+		return err
+	}
+	return c.executeScript(script, args, v)
+}
+
+// runStoredScript runs script like runScript, but only if it is stored in
+// nexus in this version already. It never changes nexus, so it is used for
+// reads, which run during plan, and returns ErrScriptNotStored otherwise.
+func (c *Client) runStoredScript(script schema.Script, args interface{}, v interface{}) error {
+	stored, err := c.getScript(script.Name)
+	if err != nil {
+		return err
+	}
+	if stored == nil || stored.Content != script.Content {
+		return ErrScriptNotStored
+	}
+	return c.executeScript(script, args, v)
+}
+
+func (c *Client) getScript(name string) (*schema.Script, error) {
+	body, resp, err := c.Get(fmt.Sprintf("%s/%s", scriptAPIEndpoint, name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read script \"%s\": HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var script schema.Script
+	if err := json.Unmarshal(body, &script); err != nil {
+		return nil, fmt.Errorf("could not unmarshal script \"%s\": %v", name, err)
+	}
+	return &script, nil
+}
+
+func (c *Client) executeScript(script schema.Script, args interface{}, v interface{}) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(args)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := c.Post(fmt.Sprintf("%s/%s/run", scriptAPIEndpoint, script.Name), ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not run script \"%s\": HTTP: %d, %s", script.Name, resp.StatusCode, string(body))
+	}
+
+	var result scriptResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("could not unmarshal result of script \"%s\": %v", script.Name, err)
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(result.Result), v); err != nil {
+		return fmt.Errorf("could not unmarshal result of script \"%s\": %v", script.Name, err)
+	}
+	return nil
+}
+
+func (c *Client) storeScript(script schema.Script) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(script)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := c.Put(fmt.Sprintf("%s/%s", scriptAPIEndpoint, script.Name), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("could not update script \"%s\": HTTP: %d, %s", script.Name, resp.StatusCode, string(body))
+	}
+
+	ioReader, err = tools.JsonMarshalInterfaceToIOReader(script)
+	if err != nil {
+		return err
+	}
+
+	body, resp, err = c.Post(scriptAPIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not create script \"%s\": HTTP: %d, %s", script.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	stagingAPIEndpoint = basePath + "v1/staging"
+)
+
+type stagingMove struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Destination string             `json:"destination"`
+		Components  []StagingComponent `json:"components moved"`
+	} `json:"data"`
+}
+
+type StagingService Service
+
+// Move moves all components matching query to the destination repository
+// and returns the moved components
+func (s *StagingService) Move(destination string, query url.Values) ([]StagingComponent, error) {
+	body, resp, err := s.Client.Post(fmt.Sprintf("%s/move/%s?%s", stagingAPIEndpoint, url.PathEscape(destination), query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not move components to repository '%s': HTTP: %d, %s", destination, resp.StatusCode, string(body))
+	}
+
+	var move stagingMove
+	if err := json.Unmarshal(body, &move); err != nil {
+		return nil, fmt.Errorf("could not unmarshal staging move: %v", err)
+	}
+	return move.Data.Components, nil
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	tagAPIEndpoint = basePath + "v1/tags"
+)
+
+type Tag struct {
+	// The name of the tag
+	Name string `json:"name"`
+	// Arbitrary attributes of the tag
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	// When the tag was created, only set when read from nexus
+	FirstCreated string `json:"firstCreated,omitempty"`
+	// When the tag was last updated, only set when read from nexus
+	LastUpdated string `json:"lastUpdated,omitempty"`
+}
+
+// StagingComponent identifies a component in the responses of the tagging and staging APIs
+type StagingComponent struct {
+	Group   string `json:"group,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type tagAssociation struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Components []StagingComponent `json:"components associated"`
+	} `json:"data"`
+}
+
+type TagService Service
+
+// Get returns the tag with the given name or nil if it does not exist
+func (s *TagService) Get(name string) (*Tag, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s/%s", tagAPIEndpoint, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read tag '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var tag Tag
+	if err := json.Unmarshal(body, &tag); err != nil {
+		return nil, fmt.Errorf("could not unmarshal tag: %v", err)
+	}
+	return &tag, nil
+}
+
+func (s *TagService) Create(tag *Tag) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(Tag{Name: tag.Name, Attributes: tag.Attributes})
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Post(tagAPIEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not create tag '%s': HTTP: %d, %s", tag.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Update replaces the attributes of the tag
+func (s *TagService) Update(tag *Tag) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(struct {
+		Attributes map[string]interface{} `json:"attributes"`
+	}{Attributes: tag.Attributes})
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.Client.Put(fmt.Sprintf("%s/%s", tagAPIEndpoint, url.PathEscape(tag.Name)), ioReader)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not update tag '%s': HTTP: %d, %s", tag.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *TagService) Delete(name string) error {
+	body, resp, err := s.Client.Delete(fmt.Sprintf("%s/%s", tagAPIEndpoint, url.PathEscape(name)))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("could not delete tag '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Associate tags all components matching query with the tag and returns
+// the tagged components
+func (s *TagService) Associate(name string, query url.Values) ([]StagingComponent, error) {
+	body, resp, err := s.Client.Post(fmt.Sprintf("%s/associate/%s?%s", tagAPIEndpoint, url.PathEscape(name), query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not associate components with tag '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+
+	var association tagAssociation
+	if err := json.Unmarshal(body, &association); err != nil {
+		return nil, fmt.Errorf("could not unmarshal tag association: %v", err)
+	}
+	return association.Data.Components, nil
+}
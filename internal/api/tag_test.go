@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagCreateAndGet(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/"+tagAPIEndpoint:
+			var tag Tag
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&tag))
+			assert.Equal(t, Tag{Name: "release-1.0", Attributes: map[string]interface{}{"jvm": "17"}}, tag)
+			w.Write([]byte(`{"name":"release-1.0","attributes":{"jvm":"17"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/"+tagAPIEndpoint+"/release-1.0":
+			w.Write([]byte(`{"name":"release-1.0","attributes":{"jvm":"17","build":{"number":42}},"firstCreated":"2024-01-02T03:04:05.000+00:00","lastUpdated":"2024-01-02T03:04:05.000+00:00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	assert.NoError(t, c.Tag.Create(&Tag{Name: "release-1.0", Attributes: map[string]interface{}{"jvm": "17"}}))
+
+	tag, err := c.Tag.Get("release-1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "17", tag.Attributes["jvm"])
+	assert.Equal(t, "2024-01-02T03:04:05.000+00:00", tag.FirstCreated)
+
+	tag, err = c.Tag.Get("missing")
+	assert.NoError(t, err)
+	assert.Nil(t, tag)
+}
+
+func TestTagAssociateAndStagingMove(t *testing.T) {
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Path {
+		case "/" + tagAPIEndpoint + "/associate/release-1.0":
+			assert.Equal(t, "maven-staging", r.URL.Query().Get("repository"))
+			assert.Equal(t, "1.0", r.URL.Query().Get("version"))
+			w.Write([]byte(`{"status":200,"message":"Association successful","data":{"components associated":[{"group":"com.example","name":"service","version":"1.0"}]}}`))
+		case "/" + stagingAPIEndpoint + "/move/maven-releases":
+			assert.Equal(t, "release-1.0", r.URL.Query().Get("tag"))
+			w.Write([]byte(`{"status":200,"message":"Move Successful","data":{"destination":"maven-releases","components moved":[{"group":"com.example","name":"service","version":"1.0"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	expected := []StagingComponent{{Group: "com.example", Name: "service", Version: "1.0"}}
+
+	components, err := c.Tag.Associate("release-1.0", url.Values{"repository": {"maven-staging"}, "version": {"1.0"}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, components)
+
+	components, err = c.Staging.Move("maven-releases", url.Values{"repository": {"maven-staging"}, "tag": {"release-1.0"}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, components)
+}
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema"
+)
+
+const (
+	taskAPIEndpoint = basePath + "v1/tasks"
+
+	taskScriptName = "terraform-provider-nexus-task"
+	taskScript     = `
+import groovy.json.JsonOutput
+import groovy.json.JsonSlurper
+import org.sonatype.nexus.scheduling.TaskScheduler
+
+def taskScheduler = container.lookup(TaskScheduler.class.getName())
+def parsedArgs = new JsonSlurper().parseText(args)
+def task = parsedArgs.task
+
+switch (parsedArgs.action) {
+    case 'create':
+        def config = taskScheduler.createTaskConfigurationInstance(task.typeId)
+        config.setName(task.name)
+        (task['properties'] ?: [:]).each { key, value -> config.setString(key, value) }
+        def info = taskScheduler.scheduleTask(config, taskScheduler.getScheduleFactory().manual())
+        return JsonOutput.toJson([id: info.getId()])
+    case 'delete':
+        def info = taskScheduler.getTaskById(task.id)
+        if (info && !(parsedArgs.unlessRunning && info.getCurrentState().getState().toString().startsWith('RUNNING'))) {
+            info.remove()
+        }
+        return JsonOutput.toJson(null)
+    case 'log':
+        // the header of each log file names the id of the task which wrote it
+        def directory = new File(System.getProperty('karaf.data'), 'log/tasks')
+        def logFile = directory.listFiles()?.findAll { it.name.startsWith(task.typeId + '-') }?.findAll { file ->
+            file.withReader { reader -> (1..20).collect { reader.readLine() }.any { it?.contains(task.id) } }
+        }?.max { it.lastModified() }
+        return JsonOutput.toJson(logFile ? logFile.readLines().takeRight(parsedArgs.lines) : [])
+}
+`
+
+	TaskStateWaiting = "WAITING"
+	TaskStateRunning = "RUNNING"
+
+	TaskResultOK = "OK"
+)
+
+// TaskPollInterval is the interval in which RunOnce polls the state of a task
+var TaskPollInterval = 5 * time.Second
+
+type Task struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// The type of the task, e.g. blobstore.compact
+	Type string `json:"type"`
+	// The message of the current or last run, e.g. the progress
+	Message string `json:"message"`
+	// The state of the task, e.g. WAITING or RUNNING
+	CurrentState string `json:"currentState"`
+	// The result of the last run, e.g. OK or FAILED, empty if the task never ran
+	LastRunResult string `json:"lastRunResult"`
+	LastRun       string `json:"lastRun"`
+	NextRun       string `json:"nextRun"`
+}
+
+// TaskConfiguration describes a task to create
+type TaskConfiguration struct {
+	ID     string `json:"id,omitempty"`
+	TypeID string `json:"typeId"`
+	Name   string `json:"name"`
+	// The properties of the task type, e.g. blobstoreName
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type taskArgs struct {
+	Action string             `json:"action"`
+	Task   *TaskConfiguration `json:"task,omitempty"`
+	// The number of lines to read from the end of the log
+	Lines int `json:"lines,omitempty"`
+	// Keep the task if it is running instead of deleting it
+	UnlessRunning bool `json:"unlessRunning,omitempty"`
+}
+
+type TaskService Service
+
+func (s *TaskService) script() schema.Script {
+	return schema.Script{
+		Name:    taskScriptName,
+		Content: taskScript,
+		Type:    "groovy",
+	}
+}
+
+// Create creates a task which is only run manually and returns its id
+func (s *TaskService) Create(task *TaskConfiguration) (string, error) {
+	var created TaskConfiguration
+	if err := s.Client.runScript(s.script(), taskArgs{Action: "create", Task: task}, &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+func (s *TaskService) Delete(id string) error {
+	return s.Client.runScript(s.script(), taskArgs{Action: "delete", Task: &TaskConfiguration{ID: id}}, nil)
+}
+
+// deleteUnlessRunning deletes the task with the given id unless it is running,
+// it is used to clean up after errors where the state of the task is unknown
+func (s *TaskService) deleteUnlessRunning(id string) {
+	if err := s.Client.runScript(s.script(), taskArgs{Action: "delete", Task: &TaskConfiguration{ID: id}, UnlessRunning: true}, nil); err != nil {
+		log.Printf("[WARN] Could not delete task %s: %v", id, err)
+	}
+}
+
+// GetLog returns up to lines lines from the end of the log of the last run
+// of the given task, nexus writes one log file per run. The log is found by
+// the id of the task, so it can be read after the task was deleted.
+func (s *TaskService) GetLog(task *Task, lines int) ([]string, error) {
+	var taskLog []string
+	if err := s.Client.runScript(s.script(), taskArgs{Action: "log", Task: &TaskConfiguration{ID: task.ID, TypeID: task.Type}, Lines: lines}, &taskLog); err != nil {
+		return nil, err
+	}
+	return taskLog, nil
+}
+
+// Get returns the task with the given id or nil if it does not exist
+func (s *TaskService) Get(id string) (*Task, error) {
+	body, resp, err := s.Client.Get(fmt.Sprintf("%s/%s", taskAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+
+	var task Task
+	if err := json.Unmarshal(body, &task); err != nil {
+		return nil, fmt.Errorf("could not unmarshal task: %v", err)
+	}
+	return &task, nil
+}
+
+// Run starts the task with the given id without waiting for it to finish
+func (s *TaskService) Run(id string) error {
+	body, resp, err := s.Client.Post(fmt.Sprintf("%s/%s/run", taskAPIEndpoint, url.PathEscape(id)), nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not run task '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// RunOnce creates a task, runs it and waits until it finished, the timeout
+// expired or the context was canceled, a timeout of 0 waits without timeout.
+// progress is called with the state of the task whenever it is polled. The
+// task is deleted once it finished, also if it failed, and its final state is
+// returned. If an error occurs before, the task is deleted unless it is still
+// running.
+func (s *TaskService) RunOnce(ctx context.Context, task *TaskConfiguration, timeout time.Duration, progress func(*Task)) (*Task, error) {
+	id, err := s.Create(task)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.runAndWait(ctx, id, task.Name, timeout, progress)
+	if err != nil {
+		s.deleteUnlessRunning(id)
+		return current, err
+	}
+
+	if err := s.Delete(id); err != nil {
+		return current, err
+	}
+	if current.LastRunResult != TaskResultOK {
+		return current, fmt.Errorf("task %s finished with result %s: %s", task.Name, current.LastRunResult, current.Message)
+	}
+	return current, nil
+}
+
+// runAndWait runs the task with the given id and polls its state until it
+// finished, see RunOnce
+func (s *TaskService) runAndWait(ctx context.Context, id string, name string, timeout time.Duration, progress func(*Task)) (*Task, error) {
+	if err := s.Run(id); err != nil {
+		return nil, err
+	}
+
+	started := time.Now()
+	var current *Task
+	for {
+		select {
+		case <-ctx.Done():
+		case <-time.After(TaskPollInterval):
+		}
+		if err := ctx.Err(); err != nil {
+			return current, fmt.Errorf("stopped waiting for task %s: %v", name, err)
+		}
+
+		var err error
+		current, err = s.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, fmt.Errorf("task %s was deleted while it was running", name)
+		}
+		if progress != nil {
+			progress(current)
+		}
+		if current.CurrentState != TaskStateRunning && current.LastRunResult != "" {
+			return current, nil
+		}
+		if timeout > 0 && time.Since(started) > timeout {
+			if current.CurrentState == TaskStateRunning {
+				return current, fmt.Errorf("task %s did not finish within %s, it keeps running in nexus", name, timeout)
+			}
+			return current, fmt.Errorf("task %s did not start within %s", name, timeout)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// getTestTaskClient fakes the task script and the task endpoints, the created
+// task must match expected, runs for the given number of polls and finishes
+// with result, it can not be run if result is empty
+func getTestTaskClient(t *testing.T, expected TaskConfiguration, polls int, result string) (*Client, *[]string) {
+	TaskPollInterval = 0
+	t.Cleanup(func() { TaskPollInterval = 5 * time.Second })
+
+	var actions []string
+	c := getTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/"+scriptAPIEndpoint):
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/"+scriptAPIEndpoint+"/"+taskScriptName+"/run":
+			body, _ := io.ReadAll(r.Body)
+			var args taskArgs
+			assert.NoError(t, json.Unmarshal(body, &args))
+			actions = append(actions, args.Action)
+
+			var scriptOutput string
+			switch args.Action {
+			case "create":
+				assert.Equal(t, expected.TypeID, args.Task.TypeID)
+				assert.Equal(t, expected.Properties, args.Task.Properties)
+				scriptOutput = `{"id":"task-1"}`
+			case "delete":
+				assert.Equal(t, "task-1", args.Task.ID)
+				if args.UnlessRunning {
+					actions[len(actions)-1] = "delete unless running"
+				}
+				scriptOutput = `null`
+			case "log":
+				assert.Equal(t, "task-1", args.Task.ID)
+				assert.Equal(t, expected.TypeID, args.Task.TypeID)
+				assert.Equal(t, 2, args.Lines)
+				scriptOutput = `["compacting","done"]`
+			}
+			output, _ := json.Marshal(scriptResult{Name: taskScriptName, Result: scriptOutput})
+			w.Write(output)
+		case r.Method == http.MethodPost && r.URL.Path == "/"+taskAPIEndpoint+"/task-1/run":
+			actions = append(actions, "run")
+			if result == "" {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/"+taskAPIEndpoint+"/task-1":
+			actions = append(actions, "get")
+			task := Task{ID: "task-1", Type: expected.TypeID, CurrentState: TaskStateRunning, Message: "moving"}
+			if polls--; polls < 0 {
+				task.CurrentState = TaskStateWaiting
+				task.LastRunResult = result
+			}
+			json.NewEncoder(w).Encode(task)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return c, &actions
+}
+
+var testRepositoryMoveTask = TaskConfiguration{
+	TypeID:     RepositoryMoveTaskType,
+	Properties: map[string]string{"moveRepositoryName": "raw", "moveTargetBlobStoreName": "store"},
+}
+
+func TestRepositoryMoveToBlobstore(t *testing.T) {
+	c, actions := getTestTaskClient(t, testRepositoryMoveTask, 2, TaskResultOK)
+
+	var messages []string
+	err := c.Repository.MoveToBlobstore(context.Background(), "raw", "store", 0, func(task *Task) {
+		messages = append(messages, task.Message)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"create", "run", "get", "get", "get", "delete"}, *actions)
+	assert.Equal(t, []string{"moving", "moving", "moving"}, messages)
+}
+
+func TestRepositoryMoveToBlobstoreFailed(t *testing.T) {
+	c, actions := getTestTaskClient(t, testRepositoryMoveTask, 0, "FAILED")
+
+	err := c.Repository.MoveToBlobstore(context.Background(), "raw", "store", 0, nil)
+	assert.EqualError(t, err, "task Move repository raw to blobstore store finished with result FAILED: moving")
+	assert.Equal(t, []string{"create", "run", "get", "delete"}, *actions)
+}
+
+func TestRepositoryMoveToBlobstoreNotRun(t *testing.T) {
+	c, actions := getTestTaskClient(t, testRepositoryMoveTask, 0, "")
+
+	err := c.Repository.MoveToBlobstore(context.Background(), "raw", "store", 0, nil)
+	assert.EqualError(t, err, "could not run task 'task-1': HTTP: 409, ")
+	assert.Equal(t, []string{"create", "run", "delete unless running"}, *actions)
+}
+
+func TestBlobstoreCompact(t *testing.T) {
+	c, actions := getTestTaskClient(t, TaskConfiguration{
+		TypeID:     BlobstoreCompactTaskType,
+		Properties: map[string]string{"blobstoreName": "store"},
+	}, 1, TaskResultOK)
+
+	task, err := c.Blobstore.Compact(context.Background(), "store", time.Minute, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, TaskResultOK, task.LastRunResult)
+
+	log, err := c.Task.GetLog(task, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"compacting", "done"}, log)
+	assert.Equal(t, []string{"create", "run", "get", "get", "delete", "log"}, *actions)
+}
+
+func TestBlobstoreReconcileTimeout(t *testing.T) {
+	c, actions := getTestTaskClient(t, TaskConfiguration{
+		TypeID: BlobstoreReconcileTaskType,
+		Properties: map[string]string{
+			"blobstoreName":  "store",
+			"dryRun":         "true",
+			"restoreBlobs":   "true",
+			"undeleteBlobs":  "false",
+			"integrityCheck": "true",
+		},
+	}, 1000, TaskResultOK)
+
+	task, err := c.Blobstore.Reconcile(context.Background(), "store", BlobstoreReconcileOptions{
+		DryRun:         true,
+		RestoreBlobs:   true,
+		IntegrityCheck: true,
+	}, time.Nanosecond, nil)
+	assert.EqualError(t, err, "task Reconcile component database from blobstore store did not finish within 1ns, it keeps running in nexus")
+	assert.Equal(t, TaskStateRunning, task.CurrentState)
+	// nexus keeps the task if it is running
+	assert.Equal(t, []string{"create", "run", "get", "delete unless running"}, *actions)
+}
+
+func TestBlobstoreCompactCanceled(t *testing.T) {
+	c, actions := getTestTaskClient(t, TaskConfiguration{
+		TypeID:     BlobstoreCompactTaskType,
+		Properties: map[string]string{"blobstoreName": "store"},
+	}, 1000, TaskResultOK)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	task, err := c.Blobstore.Compact(ctx, "store", 0, func(*Task) { cancel() })
+	assert.EqualError(t, err, "stopped waiting for task Compact blobstore store: context canceled")
+	assert.Equal(t, TaskStateRunning, task.CurrentState)
+	assert.Equal(t, []string{"create", "run", "get", "delete unless running"}, *actions)
+}
@@ -0,0 +1,493 @@
+// Package csel parses the content selector expression language (CSEL) of
+// nexus, so that content selectors can be validated at plan time instead of
+// failing at apply.
+//
+// CSEL is a subset of JEXL. An expression compares the attributes format,
+// path and coordinate.<name> of assets with strings and combines the
+// comparisons with and, or and parentheses, e.g.
+//
+//	format == "maven2" and (path =^ "/com/example/" or coordinate.groupId =~ "org\\.example.*")
+package csel
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Comparison operators of CSEL which need validation of their strings
+const (
+	operatorEqual      = "=="
+	operatorMatches    = "=~"
+	operatorStartsWith = "=^"
+)
+
+const (
+	attributeFormat           = "format"
+	attributePath             = "path"
+	attributeCoordinatePrefix = "coordinate."
+)
+
+// Position is the position of a token in an expression, lines and columns
+// start at 1 and count characters, not bytes
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	if p.Line == 1 {
+		return fmt.Sprintf("column %d", p.Column)
+	}
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// Error is a syntax or semantic error in an expression, or a warning about a
+// part of an expression which is valid but most likely a mistake
+type Error struct {
+	Position Position
+	Message  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at %s", e.Message, e.Position)
+}
+
+func errorf(position Position, format string, a ...interface{}) *Error {
+	return &Error{Position: position, Message: fmt.Sprintf(format, a...)}
+}
+
+// Validate returns an *Error if expression is no valid CSEL expression or
+// compares an attribute which nexus does not support
+func Validate(expression string) error {
+	root, err := parse(expression)
+	if err != nil {
+		return err
+	}
+	for _, c := range root.comparisons() {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Warnings returns the comparisons of a valid expression which most likely
+// do not match what was intended, e.g. paths without a leading "/", which
+// never match. It returns nothing for invalid expressions, see Validate.
+func Warnings(expression string) []*Error {
+	if Validate(expression) != nil {
+		return nil
+	}
+	root, _ := parse(expression)
+
+	var warnings []*Error
+	for _, c := range root.comparisons() {
+		if warning := c.warning(); warning != nil {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
+// Normalize returns expression with a single space between the tokens and
+// no space inside parentheses, e.g. to ignore cosmetic changes. Strings are
+// kept as they are written.
+func Normalize(expression string) (string, error) {
+	root, err := parse(expression)
+	if err != nil {
+		return "", err
+	}
+	return root.String(), nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	kind tokenKind
+	// text is the token as it is written in the expression
+	text string
+	// value is the unescaped content of strings
+	value    string
+	position Position
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return t.text
+	}
+	return strconv.Quote(t.text)
+}
+
+type lexer struct {
+	input    []rune
+	offset   int
+	position Position
+}
+
+// lex splits expression into tokens, the last token is always tokenEOF
+func lex(expression string) ([]token, error) {
+	l := &lexer{input: []rune(expression), position: Position{Line: 1, Column: 1}}
+
+	var tokens []token
+	for {
+		for l.offset < len(l.input) && unicode.IsSpace(l.input[l.offset]) {
+			l.next()
+		}
+
+		t, err := l.lexToken()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+		if t.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+// peek returns the rune n runes ahead or 0 at the end of the input
+func (l *lexer) peek(n int) rune {
+	if l.offset+n >= len(l.input) {
+		return 0
+	}
+	return l.input[l.offset+n]
+}
+
+func (l *lexer) next() rune {
+	r := l.input[l.offset]
+	l.offset++
+	if r == '\n' {
+		l.position.Line++
+		l.position.Column = 1
+	} else {
+		l.position.Column++
+	}
+	return r
+}
+
+func (l *lexer) lexToken() (token, error) {
+	start, position := l.offset, l.position
+	newToken := func(kind tokenKind) token {
+		text := string(l.input[start:l.offset])
+		return token{kind: kind, text: text, value: text, position: position}
+	}
+
+	if l.offset >= len(l.input) {
+		return newToken(tokenEOF), nil
+	}
+
+	r := l.next()
+	switch {
+	case r == '_' || unicode.IsLetter(r):
+		for c := l.peek(0); c == '_' || c == '.' || unicode.IsLetter(c) || unicode.IsDigit(c); c = l.peek(0) {
+			l.next()
+		}
+		t := newToken(tokenIdentifier)
+		switch t.text {
+		case "and":
+			t.kind = tokenAnd
+		case "or":
+			t.kind = tokenOr
+		}
+		return t, nil
+	case r == '"' || r == '\'':
+		return l.lexString(r, start, position)
+	case r == '(':
+		return newToken(tokenLeftParen), nil
+	case r == ')':
+		return newToken(tokenRightParen), nil
+	case r == '=':
+		switch l.peek(0) {
+		case '=', '~', '^':
+			l.next()
+			return newToken(tokenOperator), nil
+		}
+		return token{}, errorf(position, `unexpected "=", expected "==", "=~" or "=^"`)
+	case r == '!':
+		if l.peek(0) == '=' {
+			l.next()
+			return newToken(tokenOperator), nil
+		}
+		return token{}, errorf(position, `unexpected "!", negations are not supported, use "!=" instead`)
+	case r == '&' && l.peek(0) == '&':
+		l.next()
+		return newToken(tokenAnd), nil
+	case r == '|' && l.peek(0) == '|':
+		l.next()
+		return newToken(tokenOr), nil
+	}
+	return token{}, errorf(position, "unexpected character %q", r)
+}
+
+// lexString reads a string which started with quote. Like JEXL it unescapes
+// quotes, backslashes and unicode escapes and keeps other escapes, e.g. \.
+// in regular expressions.
+func (l *lexer) lexString(quote rune, start int, position Position) (token, error) {
+	var value strings.Builder
+	for {
+		if l.offset >= len(l.input) {
+			return token{}, errorf(position, "unterminated string")
+		}
+		r := l.next()
+		switch {
+		case r == quote:
+			return token{
+				kind:     tokenString,
+				text:     string(l.input[start:l.offset]),
+				value:    value.String(),
+				position: position,
+			}, nil
+		case r == '\\':
+			if l.offset >= len(l.input) {
+				return token{}, errorf(position, "unterminated string")
+			}
+			escaped := l.next()
+			switch escaped {
+			case '"', '\'', '\\':
+				value.WriteRune(escaped)
+			case 'u':
+				if l.offset+4 <= len(l.input) {
+					if code, err := strconv.ParseUint(string(l.input[l.offset:l.offset+4]), 16, 32); err == nil {
+						for i := 0; i < 4; i++ {
+							l.next()
+						}
+						value.WriteRune(rune(code))
+						continue
+					}
+				}
+				value.WriteString(`\u`)
+			default:
+				value.WriteRune('\\')
+				value.WriteRune(escaped)
+			}
+		default:
+			value.WriteRune(r)
+		}
+	}
+}
+
+// node is a node of the syntax tree of an expression, String returns the
+// normalized expression of the node
+type node interface {
+	String() string
+	// comparisons returns the comparisons of the node from left to right
+	comparisons() []*comparison
+}
+
+// comparison compares an attribute with a string, e.g. format == "raw"
+type comparison struct {
+	attribute token
+	operator  token
+	value     token
+}
+
+func (c *comparison) String() string {
+	return fmt.Sprintf("%s %s %s", c.attribute.text, c.operator.text, c.value.text)
+}
+
+func (c *comparison) comparisons() []*comparison {
+	return []*comparison{c}
+}
+
+// logical combines two expressions with and or or
+type logical struct {
+	operator token
+	left     node
+	right    node
+}
+
+func (l *logical) String() string {
+	return fmt.Sprintf("%s %s %s", l.left, l.operator.text, l.right)
+}
+
+func (l *logical) comparisons() []*comparison {
+	return append(l.left.comparisons(), l.right.comparisons()...)
+}
+
+// group is an expression in parentheses
+type group struct {
+	expression node
+}
+
+func (g *group) String() string {
+	return fmt.Sprintf("(%s)", g.expression)
+}
+
+func (g *group) comparisons() []*comparison {
+	return g.expression.comparisons()
+}
+
+type parser struct {
+	tokens  []token
+	current int
+}
+
+// parse returns the syntax tree of expression, and binds tighter than or:
+//
+//	expression = and { ( "or" | "||" ) and }
+//	and        = primary { ( "and" | "&&" ) primary }
+//	primary    = "(" expression ")" | attribute operator string
+func parse(expression string) (node, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t.kind != tokenEOF {
+		return nil, errorf(t.position, `expected "and" or "or", found %s`, t)
+	}
+	return root, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.current]
+	// the last token is tokenEOF, which is returned repeatedly
+	if p.current < len(p.tokens)-1 {
+		p.current++
+	}
+	return t
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		operator := p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		operator := p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenLeftParen:
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, errorf(closing.position, `expected ")" to close "(" at %s, found %s`, t.position, closing)
+		}
+		return &group{expression: expression}, nil
+	case tokenIdentifier:
+		operator := p.next()
+		if operator.kind != tokenOperator {
+			return nil, errorf(operator.position, `expected "==", "!=", "=~" or "=^" after attribute %s, found %s`, t, operator)
+		}
+		value := p.next()
+		if value.kind != tokenString {
+			return nil, errorf(value.position, "expected a quoted string after %s, found %s", operator, value)
+		}
+		return &comparison{attribute: t, operator: operator, value: value}, nil
+	}
+	return nil, errorf(t.position, `expected an attribute or "(", found %s`, t)
+}
+
+// rejectedRegexpErrors are the errors of regular expressions which are
+// invalid in java as well, others like lookarounds are only unsupported in go
+var rejectedRegexpErrors = map[syntax.ErrorCode]bool{
+	syntax.ErrInvalidCharRange:      true,
+	syntax.ErrMissingBracket:        true,
+	syntax.ErrMissingParen:          true,
+	syntax.ErrMissingRepeatArgument: true,
+	syntax.ErrTrailingBackslash:     true,
+	syntax.ErrUnexpectedParen:       true,
+}
+
+// validate returns an error if the comparison uses an attribute which nexus
+// does not support
+func (c *comparison) validate() error {
+	attribute := c.attribute.text
+	if attribute != attributeFormat && attribute != attributePath && !isCoordinateAttribute(attribute) {
+		return errorf(c.attribute.position, "unknown attribute %s, supported are format, path and coordinate.<name>", c.attribute)
+	}
+	return nil
+}
+
+// warning returns a warning if the string of the comparison most likely
+// does not mean what was intended
+func (c *comparison) warning() *Error {
+	attribute := c.attribute.text
+	value := c.value.value
+	switch c.operator.text {
+	case operatorMatches:
+		if _, err := syntax.Parse(value, syntax.Perl); err != nil {
+			if syntaxErr, ok := err.(*syntax.Error); ok && rejectedRegexpErrors[syntaxErr.Code] {
+				return errorf(c.value.position, "invalid regular expression %s: %s", c.value, syntaxErr.Code)
+			}
+			return nil
+		}
+		// nexus matches the whole path, which always starts with a slash
+		if attribute == attributePath {
+			if prefix, _ := regexp.MustCompile(value).LiteralPrefix(); prefix != "" && !strings.HasPrefix(prefix, "/") {
+				return errorf(c.value.position, `regular expression %s never matches a path, paths start with "/"`, c.value)
+			}
+		}
+	case operatorEqual, operatorStartsWith:
+		if strings.Contains(value, ".*") {
+			return errorf(c.value.position, `%s contains the regular expression ".*" but %s compares it literally, use "=~" instead`, c.value, c.operator)
+		}
+		if attribute == attributePath && !strings.HasPrefix(value, "/") {
+			return errorf(c.value.position, `%s never matches a path, paths start with "/"`, c.value)
+		}
+	}
+	return nil
+}
+
+func isCoordinateAttribute(attribute string) bool {
+	if !strings.HasPrefix(attribute, attributeCoordinatePrefix) {
+		return false
+	}
+	name := strings.TrimPrefix(attribute, attributeCoordinatePrefix)
+	return name != "" && !strings.Contains(name, ".")
+}
@@ -0,0 +1,91 @@
+package csel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		`format == "raw"`,
+		`format == 'maven2' and path =^ "/com/example/"`,
+		`format == "maven2" && (coordinate.groupId == "com.example" || coordinate.groupId =~ "org\\.example\\..*")`,
+		`path =~ "/org/[^/]+/.*" or path =~ ".*\\.jar"`,
+		`path =~ "^/com/example/.*"`,
+		`path != "com"`,
+		`format == "docker" and path =~ "(?!/v2/internal/).*"`,
+		"format == \"raw\"\n\tand path =^ \"/tmp/\"",
+		`format == "say \"hi\""`,
+		`path =~ "/café/.*"`,
+	}
+	for _, expression := range valid {
+		assert.NoError(t, Validate(expression), expression)
+		assert.Empty(t, Warnings(expression), expression)
+	}
+
+	invalid := map[string]string{
+		``:                                        `expected an attribute or "(", found end of expression at column 1`,
+		`format = "raw"`:                          `unexpected "=", expected "==", "=~" or "=^" at column 8`,
+		`format == raw`:                           `expected a quoted string after "==", found "raw" at column 11`,
+		`format "raw"`:                            `expected "==", "!=", "=~" or "=^" after attribute "format", found "raw" at column 8`,
+		`format == "raw" path =^ "/a"`:            `expected "and" or "or", found "path" at column 17`,
+		`format == "raw" and`:                     `expected an attribute or "(", found end of expression at column 20`,
+		`(format == "raw" or format == "npm"`:     `expected ")" to close "(" at column 1, found end of expression at column 36`,
+		`format == "raw")`:                        `expected "and" or "or", found ")" at column 16`,
+		`format == "raw`:                          `unterminated string at column 11`,
+		`!(format == "raw")`:                      `unexpected "!", negations are not supported, use "!=" instead at column 1`,
+		`format == "raw" & path =^ "/a"`:          `unexpected character '&' at column 17`,
+		`version == "1.0"`:                        `unknown attribute "version", supported are format, path and coordinate.<name> at column 1`,
+		`format == "raw" and coordinate =^ "a"`:   `unknown attribute "coordinate", supported are format, path and coordinate.<name> at column 21`,
+		`coordinate.a.b == "a"`:                   `unknown attribute "coordinate.a.b", supported are format, path and coordinate.<name> at column 1`,
+		`format == "raw" or (format == "npm" and`: `expected an attribute or "(", found end of expression at column 40`,
+	}
+	for expression, message := range invalid {
+		err := Validate(expression)
+		if assert.Error(t, err, expression) {
+			assert.Equal(t, message, err.Error(), expression)
+			assert.IsType(t, &Error{}, err, expression)
+		}
+		assert.Empty(t, Warnings(expression), expression)
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	warnings := map[string]string{
+		`path =~ "/com/(example"`:              `invalid regular expression "/com/(example": missing closing ) at column 9`,
+		`path =~ "*.jar"`:                      `invalid regular expression "*.jar": missing argument to repetition operator at column 9`,
+		`path =~ "com/example/.*"`:             `regular expression "com/example/.*" never matches a path, paths start with "/" at column 9`,
+		`path =^ "com/example/"`:               `"com/example/" never matches a path, paths start with "/" at column 9`,
+		`path =^ "/com/example/.*"`:            `"/com/example/.*" contains the regular expression ".*" but "=^" compares it literally, use "=~" instead at column 9`,
+		"format == \"raw\" and\n  path == 'a'": `'a' never matches a path, paths start with "/" at line 2, column 11`,
+		`format == "ü" and path =^ "x"`:        `"x" never matches a path, paths start with "/" at column 27`,
+	}
+	for expression, message := range warnings {
+		assert.NoError(t, Validate(expression), expression)
+		if actual := Warnings(expression); assert.Len(t, actual, 1, expression) {
+			assert.Equal(t, message, actual[0].Error(), expression)
+		}
+	}
+
+	// every comparison is checked
+	actual := Warnings(`path =^ "a" or (format == "raw" and path == "b")`)
+	if assert.Len(t, actual, 2) {
+		assert.Equal(t, Position{Line: 1, Column: 9}, actual[0].Position)
+		assert.Equal(t, Position{Line: 1, Column: 45}, actual[1].Position)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	normalized, err := Normalize(" format=='maven2'  and\n\t( path =^ \"/com/\"||coordinate.groupId=~ 'org\\\\..*' ) ")
+	assert.NoError(t, err)
+	assert.Equal(t, `format == 'maven2' and (path =^ "/com/" || coordinate.groupId =~ 'org\\..*')`, normalized)
+
+	// strings are kept as they are written
+	normalized, err = Normalize(`path =^ "/with  two spaces/"`)
+	assert.NoError(t, err)
+	assert.Equal(t, `path =^ "/with  two spaces/"`, normalized)
+
+	_, err = Normalize(`format ==`)
+	assert.Error(t, err)
+}
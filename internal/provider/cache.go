@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// getListCacheCollections returns the collections of the list cache which
+// resources of the given type change, nil if they change none of them
+func getListCacheCollections(resourceName string) []string {
+	switch {
+	case strings.HasPrefix(resourceName, "nexus_blobstore"):
+		return []string{api.ListCacheBlobstores}
+	case strings.HasPrefix(resourceName, "nexus_repository"):
+		return []string{api.ListCacheRepositories}
+	case resourceName == "nexus_component" || resourceName == "nexus_component_purge" || resourceName == "nexus_staging_move":
+		// components change the usage of blobstores
+		return []string{api.ListCacheBlobstores}
+	case resourceName == "nexus_script":
+		// scripts may change anything
+		return []string{api.ListCacheBlobstores, api.ListCacheRepositories}
+	}
+	return nil
+}
+
+// invalidateListCacheOnWrite wraps the functions of resource which change
+// nexus so that the lists of the given collections are read again after the
+// change. Most resources change nexus with the *nexus.NexusClient, which
+// does not know about the list cache of the api.Client. The lists are not
+// cached during the change, so that neither the resource reading itself
+// after the change nor a resource read concurrently caches an outdated list.
+func invalidateListCacheOnWrite(resource *schema.Resource, collections []string) {
+	if len(collections) == 0 {
+		return
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, m interface{}) error {
+			defer api.FromMeta(m).StartListCacheWrite(collections...)()
+			return f(d, m)
+		}
+	}
+	resource.Create = wrap(resource.Create)
+	resource.Update = wrap(resource.Update)
+	resource.Delete = wrap(resource.Delete)
+
+	wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			defer api.FromMeta(m).StartListCacheWrite(collections...)()
+			return f(ctx, d, m)
+		}
+	}
+	resource.CreateContext = wrapContext(resource.CreateContext)
+	resource.UpdateContext = wrapContext(resource.UpdateContext)
+	resource.DeleteContext = wrapContext(resource.DeleteContext)
+}
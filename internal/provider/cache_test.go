@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetListCacheCollections(t *testing.T) {
+	assert.Equal(t, []string{api.ListCacheBlobstores}, getListCacheCollections("nexus_blobstore_s3"))
+	assert.Equal(t, []string{api.ListCacheRepositories}, getListCacheCollections("nexus_repository_raw_hosted"))
+	assert.Equal(t, []string{api.ListCacheBlobstores}, getListCacheCollections("nexus_component"))
+	assert.Len(t, getListCacheCollections("nexus_script"), 2)
+	assert.Nil(t, getListCacheCollections("nexus_security_user"))
+}
+
+func TestInvalidateListCacheOnWrite(t *testing.T) {
+	var lists int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&lists, 1)
+		w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := client.Config{URL: server.URL}
+	nexusClient := nexus.NewClient(config)
+	apiClient := api.NewClient(config)
+	apiClient.EnableListCache()
+	api.Register(nexusClient, apiClient)
+
+	list := func(d *schema.ResourceData, m interface{}) error {
+		_, err := api.FromMeta(m).Blobstore.List()
+		return err
+	}
+	resource := &schema.Resource{Create: list, Read: list}
+	invalidateListCacheOnWrite(resource, []string{api.ListCacheBlobstores})
+
+	assert.NoError(t, resource.Read(nil, nexusClient))
+	assert.NoError(t, resource.Read(nil, nexusClient))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&lists))
+
+	// the list is read again within and after the change
+	assert.NoError(t, resource.Create(nil, nexusClient))
+	assert.NoError(t, resource.Read(nil, nexusClient))
+	assert.Equal(t, int64(3), atomic.LoadInt64(&lists))
+	assert.Nil(t, resource.Delete)
+}
@@ -4,24 +4,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
 	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/services/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/services/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/services/component"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/services/deprecated"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/services/other"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/services/repository"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/services/security"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/services/webhook"
 )
 
 // Provider returns a terraform.Provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
 			"nexus_anonymous":                  deprecated.DataSourceAnonymous(),
+			"nexus_assets":                     component.DataSourceAssets(),
 			"nexus_blobstore":                  deprecated.DataSourceBlobstore(),
 			"nexus_blobstore_azure":            blobstore.DataSourceBlobstoreAzure(),
 			"nexus_blobstore_file":             blobstore.DataSourceBlobstoreFile(),
+			"nexus_blobstore_google":           blobstore.DataSourceBlobstoreGoogle(),
 			"nexus_blobstore_group":            blobstore.DataSourceBlobstoreGroup(),
 			"nexus_blobstore_s3":               blobstore.DataSourceBlobstoreS3(),
+			"nexus_blobstores":                 blobstore.DataSourceBlobstores(),
+			"nexus_capability_types":           capability.DataSourceCapabilityTypes(),
+			"nexus_components":                 component.DataSourceComponents(),
+			"nexus_docker_image":               component.DataSourceDockerImage(),
+			"nexus_license":                    other.DataSourceLicense(),
+			"nexus_maven_latest_version":       component.DataSourceMavenLatestVersion(),
 			"nexus_privileges":                 deprecated.DataSourcePrivileges(),
+			"nexus_raw_file":                   component.DataSourceRawFile(),
 			"nexus_repository":                 deprecated.DataSourceRepository(),
 			"nexus_repository_apt_hosted":      repository.DataSourceRepositoryAptHosted(),
 			"nexus_repository_apt_proxy":       repository.DataSourceRepositoryAptProxy(),
@@ -81,9 +94,22 @@ func Provider() *schema.Provider {
 			"nexus_blobstore":                  deprecated.ResourceBlobstore(),
 			"nexus_blobstore_azure":            blobstore.ResourceBlobstoreAzure(),
 			"nexus_blobstore_file":             blobstore.ResourceBlobstoreFile(),
+			"nexus_blobstore_google":           blobstore.ResourceBlobstoreGoogle(),
 			"nexus_blobstore_group":            blobstore.ResourceBlobstoreGroup(),
 			"nexus_blobstore_s3":               blobstore.ResourceBlobstoreS3(),
+			"nexus_blobstore_task":             blobstore.ResourceBlobstoreTask(),
+			"nexus_capability":                 capability.ResourceCapability(),
+			"nexus_capability_audit":           capability.ResourceCapabilityAudit(),
+			"nexus_capability_base_url":        capability.ResourceCapabilityBaseURL(),
+			"nexus_capability_ui_settings":     capability.ResourceCapabilityUISettings(),
+			"nexus_component":                  component.ResourceComponent(),
+			"nexus_component_purge":            component.ResourceComponentPurge(),
 			"nexus_content_selector":           deprecated.ResourceContentSelector(),
+			"nexus_http_system_settings":       other.ResourceHTTPSystemSettings(),
+			"nexus_iq_server":                  other.ResourceIQServer(),
+			"nexus_license":                    other.ResourceLicense(),
+			"nexus_mail_config":                other.ResourceMailConfig(),
+			"nexus_mail_config_verify":         other.ResourceMailConfigVerify(),
 			"nexus_privilege":                  deprecated.ResourcePrivilege(),
 			"nexus_repository":                 deprecated.ResourceRepository(),
 			"nexus_repository_apt_hosted":      repository.ResourceRepositoryAptHosted(),
@@ -97,6 +123,7 @@ func Provider() *schema.Provider {
 			"nexus_repository_docker_group":    repository.ResourceRepositoryDockerGroup(),
 			"nexus_repository_docker_hosted":   repository.ResourceRepositoryDockerHosted(),
 			"nexus_repository_docker_proxy":    repository.ResourceRepositoryDockerProxy(),
+			"nexus_repository_firewall":        repository.ResourceRepositoryFirewall(),
 			"nexus_repository_gitlfs_hosted":   repository.ResourceRepositoryGitlfsHosted(),
 			"nexus_repository_go_group":        repository.ResourceRepositoryGoGroup(),
 			"nexus_repository_go_proxy":        repository.ResourceRepositoryGoProxy(),
@@ -140,9 +167,19 @@ func Provider() *schema.Provider {
 			"nexus_security_saml":              security.ResourceSecuritySAML(),
 			"nexus_security_user":              security.ResourceSecurityUser(),
 			"nexus_security_user_token":        security.ResourceSecurityUserToken(),
+			"nexus_staging_move":               component.ResourceStagingMove(),
+			"nexus_tag":                        component.ResourceTag(),
 			"nexus_user":                       deprecated.ResourceUser(),
+			"nexus_webhook_global":             webhook.ResourceWebhookGlobal(),
+			"nexus_webhook_repository":         webhook.ResourceWebhookRepository(),
 		},
 		Schema: map[string]*schema.Schema{
+			"cache_lists": {
+				Description: "Boolean to specify whether lists read from the API, e.g. of all blobstores or repositories, are shared by all resources of a plan or apply until a resource changes them. Repositories and repository data sources then read their configuration from the shared list of all repository settings instead of one request each. Disable it if other tools change nexus during an apply. Reading environment variable NEXUS_CACHE_LISTS, defaults to `true` if unset",
+				DefaultFunc: schema.EnvDefaultFunc("NEXUS_CACHE_LISTS", "true"),
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			"insecure": {
 				Description: "Boolean to specify wether insecure SSL connections are allowed or not. Reading environment variable NEXUS_INSECURE_SKIP_VERIFY, defaults to `true` if unset",
 				Default:     false,
@@ -171,6 +208,11 @@ func Provider() *schema.Provider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, resource := range provider.ResourcesMap {
+		invalidateListCacheOnWrite(resource, getListCacheCollections(name))
+	}
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -181,5 +223,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Username: d.Get("username").(string),
 	}
 
-	return nexus.NewClient(config), nil
+	nexusClient := nexus.NewClient(config)
+	apiClient := api.NewClient(config)
+	if d.Get("cache_lists").(bool) {
+		apiClient.EnableListCache()
+	}
+	api.Register(nexusClient, apiClient)
+
+	return nexusClient, nil
 }
@@ -0,0 +1,14 @@
+package blobstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceForceDestroy = &schema.Schema{
+		Default:     false,
+		Description: "Delete the blobstore even if repositories use it or it contains blobs, and for groups remove members even if they contain blobs, defaults to `false` if unset",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -1,8 +1,19 @@
 package blobstore
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	SoftQuotaTypeSpaceRemaining = "spaceRemainingQuota"
+	SoftQuotaTypeSpaceUsed      = "spaceUsedQuota"
+
+	// SoftQuotaMinimumLimit is the smallest limit nexus accepts, 1 MB
+	SoftQuotaMinimumLimit = 1000000
 )
 
 var (
@@ -11,16 +22,39 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"limit": {
-					Description:  "The limit in Bytes. Minimum value is 1000000",
-					Required:     true,
-					Type:         schema.TypeInt,
-					ValidateFunc: validation.IntAtLeast(100000),
+					Description: fmt.Sprintf("The limit in Bytes. Minimum value is %d", SoftQuotaMinimumLimit),
+					Required:    true,
+					Type:        schema.TypeInt,
 				},
 				"type": {
-					Description:  "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
-					Required:     true,
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{"spaceRemainingQuota", "spaceUsedQuota"}, false),
+					Description: "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
+					Required:    true,
+					Type:        schema.TypeString,
+				},
+			},
+		},
+		MaxItems: 1,
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+
+	// ResourceCloudSoftQuota is the soft quota of blobstores in cloud storage,
+	// it warns about quotas of the remaining space, which is unlimited. Like
+	// ResourceSoftQuota, it is validated by ValidateSoftQuota.
+	ResourceCloudSoftQuota = &schema.Schema{
+		Description: "Soft quota of the blobstore",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"limit": {
+					Description: fmt.Sprintf("The limit in Bytes. Minimum value is %d", SoftQuotaMinimumLimit),
+					Required:    true,
+					Type:        schema.TypeInt,
+				},
+				"type": {
+					Description:      "The type to use such as spaceRemainingQuota, or spaceUsedQuota. The remaining space of cloud storage is unlimited, so a spaceRemainingQuota is never violated and only causes a warning",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: ValidateCloudSoftQuotaType,
 				},
 			},
 		},
@@ -34,7 +68,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"limit": {
-					Description: "The limit in Bytes. Minimum value is 1000000",
+					Description: fmt.Sprintf("The limit in Bytes. Minimum value is %d", SoftQuotaMinimumLimit),
 					Type:        schema.TypeInt,
 					Computed:    true,
 				},
@@ -49,3 +83,40 @@ var (
 		Type:     schema.TypeList,
 	}
 )
+
+// ValidateCloudSoftQuotaType warns if the soft quota of a blobstore in cloud
+// storage limits the remaining space, ValidateSoftQuota validates the type
+func ValidateCloudSoftQuotaType(v interface{}, p cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if v.(string) == SoftQuotaTypeSpaceRemaining {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("soft_quota of type %s is never violated", SoftQuotaTypeSpaceRemaining),
+			Detail:        fmt.Sprintf("The space of blobstores in cloud storage is unlimited, use %s instead", SoftQuotaTypeSpaceUsed),
+			AttributePath: p,
+		})
+	}
+	return diags
+}
+
+// ValidateSoftQuota validates the type and limit of the soft quota of a
+// blobstore. It is called by the CustomizeDiff of all blobstore resources,
+// the soft quota schemas do not validate the attributes themselves.
+func ValidateSoftQuota(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("soft_quota") || len(diff.Get("soft_quota").([]interface{})) == 0 {
+		return nil
+	}
+
+	if diff.NewValueKnown("soft_quota.0.type") {
+		quotaType := diff.Get("soft_quota.0.type").(string)
+		if quotaType != SoftQuotaTypeSpaceRemaining && quotaType != SoftQuotaTypeSpaceUsed {
+			return fmt.Errorf("the type of soft_quota must be %s or %s, got %s", SoftQuotaTypeSpaceRemaining, SoftQuotaTypeSpaceUsed, quotaType)
+		}
+	}
+	if diff.NewValueKnown("soft_quota.0.limit") {
+		if limit := diff.Get("soft_quota.0.limit").(int); limit < SoftQuotaMinimumLimit {
+			return fmt.Errorf("the limit of soft_quota must be at least %d bytes, got %d", SoftQuotaMinimumLimit, limit)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package capability
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceEnabled = &schema.Schema{
+		Default:     true,
+		Description: "Whether the capability is enabled, defaults to `true` if unset",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+)
@@ -0,0 +1,13 @@
+package capability
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceNotes = &schema.Schema{
+		Description: "Notes about the capability",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+)
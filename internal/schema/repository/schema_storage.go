@@ -16,7 +16,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"blob_store_name": {
-					Description: "Blob store used to store repository contents",
+					Description: "Blob store used to store repository contents. Changing it moves the content of the repository to the new blob store, which requires Nexus Pro. The update waits until the move finished, at most `timeouts.update` which defaults to 60 minutes",
 					Required:    true,
 					Set: func(v interface{}) int {
 						return schema.HashString(strings.ToLower(v.(string)))
@@ -60,7 +60,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"blob_store_name": {
-					Description: "Blob store used to store repository contents",
+					Description: "Blob store used to store repository contents. Changing it moves the content of the repository to the new blob store, which requires Nexus Pro. The update waits until the move finished, at most `timeouts.update` which defaults to 60 minutes",
 					Required:    true,
 					Set: func(v interface{}) int {
 						return schema.HashString(strings.ToLower(v.(string)))
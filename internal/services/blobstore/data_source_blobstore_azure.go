@@ -33,7 +33,7 @@ Use this data source to get details of an existing Nexus Azure blobstore.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"authentication_method": {
-										Description: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` and `MANAGEDIDENTITY`",
+										Description: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY`, `MANAGEDIDENTITY` and `ENVIRONMENTVARIABLE`",
 										Computed:    true,
 										Type:        schema.TypeString,
 									},
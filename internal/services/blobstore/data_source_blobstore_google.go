@@ -0,0 +1,77 @@
+package blobstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func DataSourceBlobstoreGoogle() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this data source to get details of an existing Nexus Google Cloud Storage blobstore.`,
+
+		Read: dataSourceBlobstoreGoogleRead,
+		Schema: map[string]*schema.Schema{
+			"id":                  common.DataSourceID,
+			"name":                blobstore.DataSourceName,
+			"blob_count":          blobstore.DataSourceBlobCount,
+			"soft_quota":          blobstore.DataSourceSoftQuota,
+			"total_size_in_bytes": blobstore.DataSourceTotalSizeInBytes,
+			"bucket_configuration": {
+				Description: "The Google Cloud Storage bucket configuration",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Description: "The Google Cloud Storage bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"region": {
+										Description: "The region of the bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"prefix": {
+										Description: "The prefix of the objects of the blobstore in the bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Computed: true,
+							Type:     schema.TypeList,
+						},
+						"bucket_security": {
+							Description: "The authentication against Google Cloud Storage",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_method": {
+										Description: "How nexus authenticates. Possible values: `accountKey` and `applicationDefault`",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Computed: true,
+							Type:     schema.TypeList,
+						},
+					},
+				},
+				Computed: true,
+				Type:     schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceBlobstoreGoogleRead(resourceData *schema.ResourceData, m interface{}) error {
+	resourceData.SetId(resourceData.Get("name").(string))
+
+	return resourceBlobstoreGoogleRead(resourceData, m)
+}
@@ -0,0 +1,49 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccDataSourceBlobstoreGoogle(t *testing.T) {
+	if tools.GetEnv("SKIP_GOOGLE_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus blobstore for Google Cloud Storage tests")
+	}
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	dataSourceName := "data.nexus_blobstore_google.acceptance"
+	bs := testAccResourceBlobstoreGoogle(fmt.Sprintf("test-blobstore-google-%s", acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreGoogleConfig(bs) + testAccDataSourceBlobstoreGoogleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", bs.Name),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket.0.name", bs.BucketConfiguration.Bucket.Name),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket.0.region", bs.BucketConfiguration.Bucket.Region),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket_security.0.authentication_method", bs.BucketConfiguration.BucketSecurity.AuthenticationMethod),
+					resource.TestCheckResourceAttr(dataSourceName, "soft_quota.0.type", bs.SoftQuota.Type),
+					resource.TestCheckResourceAttrSet(dataSourceName, "blob_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_size_in_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBlobstoreGoogleConfig() string {
+	return `
+data "nexus_blobstore_google" "acceptance" {
+	name = nexus_blobstore_google.acceptance.name
+}`
+}
@@ -40,6 +40,11 @@ func DataSourceBlobstoreS3() *schema.Resource {
 										Computed:    true,
 										Type:        schema.TypeString,
 									},
+									"max_connection_pool_size": {
+										Description: "The connection pool size of the s3 client for this blobstore, `0` if the default of nexus is used.",
+										Computed:    true,
+										Type:        schema.TypeInt,
+									},
 								},
 							},
 							Computed: true,
@@ -105,6 +110,30 @@ func DataSourceBlobstoreS3() *schema.Resource {
 							Computed: true,
 							Type:     schema.TypeList,
 						},
+						"failover_buckets": {
+							Description: "PRO Feature: Buckets in other regions nexus replicates the blobs to and fails over to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Description: "The AWS region of the failover bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"bucket_name": {
+										Description: "The name of the failover bucket",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Computed: true,
+							Type:     schema.TypeList,
+						},
+						"active_region": {
+							Description: "The region of the bucket nexus currently uses",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
 						"encryption": {
 							Description: "Additional bucket encryption configurations",
 							Elem: &schema.Resource{
@@ -1,13 +1,10 @@
 package blobstore_test
 
 import (
-	"fmt"
 	"strconv"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
 )
@@ -20,22 +17,7 @@ func TestAccDataSourceBlobstoreS3(t *testing.T) {
 	dataSourceName := "data.nexus_blobstore_s3.acceptance"
 	awsAccessKeyID := tools.GetEnv("AWS_ACCESS_KEY_ID", "")
 	awsSecretAccessKey := tools.GetEnv("AWS_SECRET_ACCESS_KEY", "")
-	forcePathStyle := true
-
-	bs := blobstore.S3{
-		Name: fmt.Sprintf("test-blobstore-s3-%s", acctest.RandString(5)),
-		BucketConfiguration: blobstore.S3BucketConfiguration{
-			Bucket: blobstore.S3Bucket{
-				Name:       tools.GetEnv("AWS_BUCKET_NAME", "terraform-provider-nexus-s3-test"),
-				Region:     tools.GetEnv("AWS_DEFAULT_REGION", "eu-central-1"),
-				Expiration: 0,
-			},
-			AdvancedBucketConnection: &blobstore.S3AdvancedBucketConnection{
-				Endpoint:       tools.GetEnv("AWS_ENDPOINT", ""),
-				ForcePathStyle: &forcePathStyle,
-			},
-		},
-	}
+	bs := testAccResourceBlobstoreS3()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acceptance.AccPreCheck(t) },
@@ -50,6 +32,8 @@ func TestAccDataSourceBlobstoreS3(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.bucket.0.expiration", strconv.FormatInt(int64(bs.BucketConfiguration.Bucket.Expiration), 10)),
 					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.advanced_bucket_connection.0.endpoint", bs.BucketConfiguration.AdvancedBucketConnection.Endpoint),
 					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.advanced_bucket_connection.0.force_path_style", strconv.FormatBool(*bs.BucketConfiguration.AdvancedBucketConnection.ForcePathStyle)),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.advanced_bucket_connection.0.signer_type", bs.BucketConfiguration.AdvancedBucketConnection.SignerType),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_configuration.0.advanced_bucket_connection.0.max_connection_pool_size", strconv.Itoa(int(*bs.BucketConfiguration.AdvancedBucketConnection.MaxConnectionPoolSize))),
 					resource.TestCheckResourceAttrSet(dataSourceName, "blob_count"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "total_size_in_bytes"),
 				),
@@ -0,0 +1,103 @@
+package blobstore
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	blobstoreSchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func DataSourceBlobstores() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to get a list with all blobstores and their usage, e.g. to alert on nearly full blobstores.`,
+
+		Read: dataSourceBlobstoresRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"items": {
+				Description: "A list of all blobstores",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the blobstore",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"type": {
+							Description: "The type of the blobstore, e.g. `File`, `S3` or `Group`",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"unavailable": {
+							Description: "Whether the blobstore is unavailable, e.g. because its storage can not be reached",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"available_space_in_bytes": blobstoreSchema.DataSourceAvailableSpaceInBytes,
+						"blob_count":               blobstoreSchema.DataSourceBlobCount,
+						"total_size_in_bytes":      blobstoreSchema.DataSourceTotalSizeInBytes,
+						"soft_quota":               blobstoreSchema.DataSourceSoftQuota,
+						"quota_violation": {
+							Description: "Whether the soft quota of the blobstore is violated, always `false` without soft quota",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"quota_message": {
+							Description: "The message of nexus about the soft quota, e.g. how far the limit is exceeded, empty without soft quota",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlobstoresRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	genericBlobstores, err := client.Blobstore.List()
+	if err != nil {
+		return err
+	}
+
+	items := make([]map[string]interface{}, 0, len(genericBlobstores))
+	for _, generic := range genericBlobstores {
+		item := map[string]interface{}{
+			"name":                     generic.Name,
+			"type":                     generic.Type,
+			"unavailable":              generic.Unavailable,
+			"available_space_in_bytes": generic.AvailableSpaceInBytes,
+			"blob_count":               generic.BlobCount,
+			"total_size_in_bytes":      generic.TotalSizeInBytes,
+			"soft_quota":               flattenSoftQuota(generic.SoftQuota),
+			"quota_violation":          false,
+			"quota_message":            "",
+		}
+
+		// nexus only evaluates the quota of blobstores with a soft quota
+		if generic.SoftQuota != nil {
+			status, err := client.Blobstore.GetQuotaStatus(generic.Name)
+			if err != nil {
+				return err
+			}
+			if status != nil {
+				item["quota_violation"] = status.IsViolation
+				item["quota_message"] = status.Message
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	if err := resourceData.Set("items", items); err != nil {
+		return fmt.Errorf("error reading blobstores: %s", err)
+	}
+	resourceData.SetId("blobstores")
+	return nil
+}
@@ -0,0 +1,58 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func testAccDataSourceBlobstoresConfig() string {
+	return `
+data "nexus_blobstores" "acceptance" {
+	depends_on = [nexus_blobstore_file.acceptance]
+}`
+}
+
+func TestAccDataSourceBlobstores(t *testing.T) {
+	dataSourceName := "data.nexus_blobstores.acceptance"
+
+	// no disk has that much space remaining, so the quota is always violated
+	bs := blobstore.File{
+		Name: fmt.Sprintf("test-blobstore-%s", acctest.RandString(5)),
+		Path: "/nexus-data/acceptance",
+		SoftQuota: &blobstore.SoftQuota{
+			Limit: 1000000000000000000,
+			Type:  "spaceRemainingQuota",
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreFileConfig(bs) + testAccDataSourceBlobstoresConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "items.*", map[string]string{
+						"name":              bs.Name,
+						"type":              "File",
+						"unavailable":       "false",
+						"soft_quota.#":      "1",
+						"soft_quota.0.type": bs.SoftQuota.Type,
+						"quota_violation":   "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "items.*", map[string]string{
+						"name":            "default",
+						"soft_quota.#":    "0",
+						"quota_violation": "false",
+						"quota_message":   "",
+					}),
+				),
+			},
+		},
+	})
+}
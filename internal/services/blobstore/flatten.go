@@ -3,6 +3,7 @@ package blobstore
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
 )
 
 func flattenSoftQuota(softQuota *blobstore.SoftQuota) []map[string]interface{} {
@@ -31,6 +32,7 @@ func flattenBucketConfigurationAuthentication(authenticationConfig *blobstore.Az
 	data := map[string]interface{}{
 		"authentication_method": string(authenticationConfig.AuthenticationMethod),
 	}
+	// nexus does not return the key, so keep the configured key
 	if accountKey, ok := resourceData.GetOk("bucket_configuration.0.authentication.0.account_key"); ok {
 		data["account_key"] = accountKey
 	}
@@ -38,16 +40,18 @@ func flattenBucketConfigurationAuthentication(authenticationConfig *blobstore.Az
 	return []map[string]interface{}{data}
 }
 
-func flattenS3BucketConfiguration(bucketConfig *blobstore.S3BucketConfiguration, resourceData *schema.ResourceData) []map[string]interface{} {
+func flattenS3BucketConfiguration(bucketConfig *api.S3BlobstoreBucketConfiguration, resourceData *schema.ResourceData) []map[string]interface{} {
 	if bucketConfig == nil {
 		return nil
 	}
 	return []map[string]interface{}{
 		{
+			"active_region":              bucketConfig.ActiveRegion,
 			"advanced_bucket_connection": flattenAdvancedBucketConnection(bucketConfig.AdvancedBucketConnection),
 			"bucket":                     flattenBucket(bucketConfig.Bucket),
 			"bucket_security":            flattenBucketSecurity(bucketConfig.BucketSecurity, resourceData),
 			"encryption":                 flattenEncryption(bucketConfig.Encryption),
+			"failover_buckets":           flattenFailoverBuckets(bucketConfig.FailoverBuckets),
 		},
 	}
 }
@@ -56,13 +60,30 @@ func flattenAdvancedBucketConnection(bucketConnection *blobstore.S3AdvancedBucke
 	if bucketConnection == nil {
 		return nil
 	}
-	return []map[string]interface{}{
-		{
-			"endpoint":         bucketConnection.Endpoint,
-			"force_path_style": bucketConnection.ForcePathStyle,
-			"signer_type":      bucketConnection.SignerType,
-		},
+	data := map[string]interface{}{
+		"endpoint":                 bucketConnection.Endpoint,
+		"force_path_style":         false,
+		"max_connection_pool_size": 0,
+		"signer_type":              bucketConnection.SignerType,
+	}
+	if bucketConnection.ForcePathStyle != nil {
+		data["force_path_style"] = *bucketConnection.ForcePathStyle
 	}
+	if bucketConnection.MaxConnectionPoolSize != nil {
+		data["max_connection_pool_size"] = int(*bucketConnection.MaxConnectionPoolSize)
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenFailoverBuckets(failoverBuckets []api.S3FailoverBucket) []map[string]interface{} {
+	data := make([]map[string]interface{}, 0, len(failoverBuckets))
+	for _, failoverBucket := range failoverBuckets {
+		data = append(data, map[string]interface{}{
+			"region":      failoverBucket.Region,
+			"bucket_name": failoverBucket.BucketName,
+		})
+	}
+	return data
 }
 
 func flattenBucket(bucket blobstore.S3Bucket) []map[string]interface{} {
@@ -101,3 +122,26 @@ func flattenEncryption(encryption *blobstore.S3Encryption) []map[string]interfac
 		},
 	}
 }
+
+func flattenGoogleBucketConfiguration(bucketConfig *api.GoogleBlobstoreBucketConfiguration, resourceData *schema.ResourceData) []map[string]interface{} {
+	data := map[string]interface{}{
+		"bucket": []map[string]interface{}{
+			{
+				"name":   bucketConfig.Bucket.Name,
+				"prefix": bucketConfig.Bucket.Prefix,
+				"region": bucketConfig.Bucket.Region,
+			},
+		},
+	}
+	if bucketConfig.BucketSecurity != nil {
+		bucketSecurity := map[string]interface{}{
+			"authentication_method": bucketConfig.BucketSecurity.AuthenticationMethod,
+		}
+		// nexus does not return the account key
+		if accountKey, ok := resourceData.GetOk("bucket_configuration.0.bucket_security.0.account_key"); ok {
+			bucketSecurity["account_key"] = accountKey
+		}
+		data["bucket_security"] = []map[string]interface{}{bucketSecurity}
+	}
+	return []map[string]interface{}{data}
+}
@@ -0,0 +1,169 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// blobstoreTypeGroup is the type of group blobstores in the blobstore list
+const blobstoreTypeGroup = "Group"
+
+// checkBlobstoreUnused returns an error naming the repositories using the
+// blobstore and its blob count, unless no repository uses it and it is
+// empty. action describes the refused operation, e.g. "delete blobstore x".
+func checkBlobstoreUnused(m interface{}, name string, action string) error {
+	repositories, err := api.FromMeta(m).Repository.ListByBlobstore(name)
+	if err != nil {
+		return err
+	}
+
+	generic, err := getGenericBlobstore(m, name)
+	if err != nil {
+		return err
+	}
+
+	return getBlobstoreInUseError(action, repositories, generic.BlobCount)
+}
+
+func getBlobstoreInUseError(action string, repositories []string, blobCount int) error {
+	var reasons []string
+	if len(repositories) > 0 {
+		reasons = append(reasons, fmt.Sprintf("it is used by the repositories %s", strings.Join(repositories, ", ")))
+	}
+	if blobCount > 0 {
+		reasons = append(reasons, fmt.Sprintf("it contains %d blobs", blobCount))
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return fmt.Errorf("refusing to %s because %s, set force_destroy = true to do it anyway", action, strings.Join(reasons, " and "))
+}
+
+// checkBlobstoreGroupMemberEmpty returns an error if the member of a group
+// contains blobs. Repositories always use the group and never its members,
+// so only the blob count is checked.
+func checkBlobstoreGroupMemberEmpty(m interface{}, group string, member string) error {
+	generic, err := getGenericBlobstore(m, member)
+	if err != nil {
+		return err
+	}
+	return getBlobstoreGroupMemberNotEmptyError(group, member, generic.BlobCount)
+}
+
+func getBlobstoreGroupMemberNotEmptyError(group string, member string, blobCount int) error {
+	if blobCount == 0 {
+		return nil
+	}
+	return fmt.Errorf("refusing to remove blobstore %s from group %s because it contains %d blobs, which the repositories using the group could no longer read, set force_destroy = true to do it anyway", member, group, blobCount)
+}
+
+// isBlobstoreConvertedToGroup reports whether the blobstore with the given
+// name is a group now, i.e. it was converted by a nexus_blobstore_group and
+// the blobstore itself was renamed. Resources of the original blobstore must
+// neither read nor delete the group.
+func isBlobstoreConvertedToGroup(m interface{}, name string) (bool, error) {
+	generic, err := getGenericBlobstore(m, name)
+	if err != nil {
+		return false, err
+	}
+	return generic.Type == blobstoreTypeGroup, nil
+}
+
+// checkBlobstoreNotConverted returns an error explaining what to do with a
+// resource managing a blobstore which was converted into a group. The
+// resource must not read the group, and silently removing it from the state
+// would make terraform create the blobstore again with the name of the group.
+func checkBlobstoreNotConverted(m interface{}, name string) error {
+	converted, err := isBlobstoreConvertedToGroup(m, name)
+	if err != nil || !converted {
+		return err
+	}
+	return getBlobstoreConvertedError(name)
+}
+
+func getBlobstoreConvertedError(name string) error {
+	return fmt.Errorf("blobstore %s was converted into a group and renamed to a member of the group, this resource no longer manages it. Replace its block by a removed block or remove it from the state with terraform state rm, the renamed blobstore can be imported under its new name", name)
+}
+
+// getGenericBlobstore returns the usage information of the blobstore with
+// the given name, which is not part of the configuration of the blobstore.
+// It is empty if the blobstore does not exist. The list of all blobstores is
+// shared by the resources of a plan or apply, see api.Client.EnableListCache.
+func getGenericBlobstore(m interface{}, name string) (blobstore.Generic, error) {
+	genericBlobstores, err := api.FromMeta(m).Blobstore.List()
+	if err != nil {
+		return blobstore.Generic{}, err
+	}
+	for _, generic := range genericBlobstores {
+		if generic.Name == name {
+			return generic, nil
+		}
+	}
+	return blobstore.Generic{}, nil
+}
+
+// importBlobstoreState sets the default of force_destroy, which is not
+// stored in nexus, so that imported resources do not show a diff
+func importBlobstoreState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("force_destroy", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// hashAzureAccountKey returns the hex encoded SHA-256 hash of an account key
+// or an empty string without key
+func hashAzureAccountKey(accountKey string) string {
+	if accountKey == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(accountKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func validateAzureAuthentication(method string, accountKey string) error {
+	if method == string(blobstore.AzureAuthenticationMethodAccountKey) && accountKey == "" {
+		return fmt.Errorf("account_key is required if authentication_method is %s", method)
+	}
+	if method != string(blobstore.AzureAuthenticationMethodAccountKey) && accountKey != "" {
+		return fmt.Errorf("account_key can only be set if authentication_method is %s, not %s", blobstore.AzureAuthenticationMethodAccountKey, method)
+	}
+	return nil
+}
+
+// normalizeBlobstoreFilePath removes redundant separators and dots from the
+// path of a file blobstore
+func normalizeBlobstoreFilePath(p string) string {
+	if p == "" {
+		return p
+	}
+	return path.Clean(p)
+}
+
+// suppressBlobstoreFilePathDiff suppresses differences between equivalent
+// paths of a file blobstore. Relative paths are resolved by nexus below the
+// blobs directory in its work directory, so a relative path is equivalent to
+// the absolute path nexus returns if that is the blobs directory followed by
+// the relative path.
+func suppressBlobstoreFilePathDiff(k, old, new string, d *schema.ResourceData) bool {
+	old, new = normalizeBlobstoreFilePath(old), normalizeBlobstoreFilePath(new)
+	if old == new {
+		return true
+	}
+	if old == "" || new == "" || path.IsAbs(old) == path.IsAbs(new) {
+		return false
+	}
+	absolute, relative := old, new
+	if path.IsAbs(new) {
+		absolute, relative = new, old
+	}
+	return strings.HasSuffix(absolute, "/blobs/"+relative)
+}
@@ -0,0 +1,88 @@
+package blobstore
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	blobstoreSchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/blobstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlobstoreInUseError(t *testing.T) {
+	assert.NoError(t, getBlobstoreInUseError("delete blobstore store", nil, 0))
+
+	assert.EqualError(t,
+		getBlobstoreInUseError("delete blobstore store", []string{"raw-a", "raw-b"}, 0),
+		"refusing to delete blobstore store because it is used by the repositories raw-a, raw-b, set force_destroy = true to do it anyway")
+
+	assert.EqualError(t,
+		getBlobstoreInUseError("delete blobstore store", nil, 3),
+		"refusing to delete blobstore store because it contains 3 blobs, set force_destroy = true to do it anyway")
+
+	assert.EqualError(t,
+		getBlobstoreInUseError("delete blobstore store", []string{"raw-a"}, 3),
+		"refusing to delete blobstore store because it is used by the repositories raw-a and it contains 3 blobs, set force_destroy = true to do it anyway")
+}
+
+func TestGetBlobstoreGroupMemberNotEmptyError(t *testing.T) {
+	assert.NoError(t, getBlobstoreGroupMemberNotEmptyError("group", "store", 0))
+	assert.EqualError(t,
+		getBlobstoreGroupMemberNotEmptyError("group", "store", 3),
+		"refusing to remove blobstore store from group group because it contains 3 blobs, which the repositories using the group could no longer read, set force_destroy = true to do it anyway")
+}
+
+func TestGetBlobstoreConvertedError(t *testing.T) {
+	assert.EqualError(t,
+		getBlobstoreConvertedError("store"),
+		"blobstore store was converted into a group and renamed to a member of the group, this resource no longer manages it. Replace its block by a removed block or remove it from the state with terraform state rm, the renamed blobstore can be imported under its new name")
+}
+
+func TestValidateCloudSoftQuotaType(t *testing.T) {
+	diags := blobstoreSchema.ValidateCloudSoftQuotaType(blobstoreSchema.SoftQuotaTypeSpaceUsed, cty.Path{})
+	assert.Empty(t, diags)
+
+	diags = blobstoreSchema.ValidateCloudSoftQuotaType(blobstoreSchema.SoftQuotaTypeSpaceRemaining, cty.Path{})
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "soft_quota of type spaceRemainingQuota is never violated", diags[0].Summary)
+	}
+
+	// unknown types are rejected by the CustomizeDiff, see ValidateSoftQuota
+	diags = blobstoreSchema.ValidateCloudSoftQuotaType("unknown", cty.Path{})
+	assert.Empty(t, diags)
+}
+
+func TestHashAzureAccountKey(t *testing.T) {
+	hash := hashAzureAccountKey("secret")
+	assert.Equal(t, "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b", hash)
+	assert.Equal(t, "", hashAzureAccountKey(""))
+}
+
+func TestValidateAzureAuthentication(t *testing.T) {
+	assert.NoError(t, validateAzureAuthentication("ACCOUNTKEY", "secret"))
+	assert.NoError(t, validateAzureAuthentication("MANAGEDIDENTITY", ""))
+	assert.NoError(t, validateAzureAuthentication("ENVIRONMENTVARIABLE", ""))
+
+	assert.EqualError(t, validateAzureAuthentication("ACCOUNTKEY", ""), "account_key is required if authentication_method is ACCOUNTKEY")
+	assert.EqualError(t, validateAzureAuthentication("ENVIRONMENTVARIABLE", "secret"), "account_key can only be set if authentication_method is ACCOUNTKEY, not ENVIRONMENTVARIABLE")
+}
+
+func TestNormalizeBlobstoreFilePath(t *testing.T) {
+	assert.Equal(t, "", normalizeBlobstoreFilePath(""))
+	assert.Equal(t, "/nexus-data/blobs/store", normalizeBlobstoreFilePath("/nexus-data//blobs/./store/"))
+	assert.Equal(t, "store", normalizeBlobstoreFilePath("./store/"))
+}
+
+func TestSuppressBlobstoreFilePathDiff(t *testing.T) {
+	assert.True(t, suppressBlobstoreFilePathDiff("path", "/nexus-data/store", "/nexus-data/store/", nil))
+	assert.True(t, suppressBlobstoreFilePathDiff("path", "/nexus-data/blobs/store", "store", nil))
+	assert.True(t, suppressBlobstoreFilePathDiff("path", "team/store", "/nexus-data/blobs/team/store", nil))
+
+	assert.False(t, suppressBlobstoreFilePathDiff("path", "/nexus-data/blobs/store", "other", nil))
+	assert.False(t, suppressBlobstoreFilePathDiff("path", "/nexus-data/blobs/mystore", "store", nil))
+	assert.False(t, suppressBlobstoreFilePathDiff("path", "/mnt/disk1/store", "store", nil))
+	assert.False(t, suppressBlobstoreFilePathDiff("path", "/nexus-data/blobs/store", "blobs/store", nil))
+	assert.False(t, suppressBlobstoreFilePathDiff("path", "/nexus-data/store", "/mnt/store", nil))
+	assert.False(t, suppressBlobstoreFilePathDiff("path", "", "store", nil))
+}
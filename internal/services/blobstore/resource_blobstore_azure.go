@@ -1,6 +1,7 @@
 package blobstore
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -18,21 +19,28 @@ func ResourceBlobstoreAzure() *schema.Resource {
 
 Use this resource to create a Nexus Azure blobstore.`,
 
-		Create: resourceBlobstoreAzureCreate,
-		Read:   resourceBlobstoreAzureRead,
-		Update: resourceBlobstoreAzureUpdate,
-		Delete: resourceBlobstoreAzureDelete,
-		Exists: resourceBlobstoreAzureExists,
+		Create:        resourceBlobstoreAzureCreate,
+		Read:          resourceBlobstoreAzureRead,
+		Update:        resourceBlobstoreAzureUpdate,
+		Delete:        resourceBlobstoreAzureDelete,
+		Exists:        resourceBlobstoreAzureExists,
+		CustomizeDiff: resourceBlobstoreAzureCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importBlobstoreState,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"id":                  common.ResourceID,
 			"name":                blobstoreSchema.ResourceName,
+			"force_destroy":       blobstoreSchema.ResourceForceDestroy,
 			"blob_count":          blobstoreSchema.ResourceBlobCount,
-			"soft_quota":          blobstoreSchema.ResourceSoftQuota,
+			"soft_quota":          blobstoreSchema.ResourceCloudSoftQuota,
 			"total_size_in_bytes": blobstoreSchema.ResourceTotalSizeInBytes,
+			"account_key_hash": {
+				Description: "The hex encoded SHA-256 hash of the account key nexus was configured with by terraform. Nexus never returns the key, so changes of the key are detected by comparing the hashes, e.g. after an import",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
 			"bucket_configuration": {
 				Description: "The Azure specific configuration details for the Azure object that'll contain the blob store",
 				Elem: &schema.Resource{
@@ -47,14 +55,19 @@ Use this resource to create a Nexus Azure blobstore.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"authentication_method": {
-										Description:  "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` and `MANAGEDIDENTITY`",
-										Required:     true,
-										Type:         schema.TypeString,
-										ValidateFunc: validation.StringInSlice([]string{string(blobstore.AzureAuthenticationMethodAccountKey), string(blobstore.AzureAuthenticationMethodManagedIdentity)}, false),
+										Description: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` for the `account_key`, `MANAGEDIDENTITY` for the managed identity of the nexus host and `ENVIRONMENTVARIABLE` for the credentials in the environment variables of nexus",
+										Required:    true,
+										Type:        schema.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(blobstore.AzureAuthenticationMethodAccountKey),
+											string(blobstore.AzureAuthenticationMethodManagedIdentity),
+											string(azureAuthenticationMethodEnvironmentVariable),
+										}, false),
 									},
 									"account_key": {
-										Description: "The account key. Required if `authentication_method` is `ACCOUNTKEY`",
+										Description: "The account key, required if and only if `authentication_method` is `ACCOUNTKEY`. Nexus never returns the key, so it is not refreshed, see `account_key_hash`",
 										Optional:    true,
+										Sensitive:   true,
 										Type:        schema.TypeString,
 									},
 								},
@@ -78,6 +91,8 @@ Use this resource to create a Nexus Azure blobstore.`,
 	}
 }
 
+const azureAuthenticationMethodEnvironmentVariable blobstore.AzureAuthenticationMethod = "ENVIRONMENTVARIABLE"
+
 func getBlobstoreAzureFromResourceData(d *schema.ResourceData) blobstore.Azure {
 	bucketConfiguration := d.Get("bucket_configuration").([]interface{})[0].(map[string]interface{})
 	authenticationConfig := bucketConfiguration["authentication"].([]interface{})[0].(map[string]interface{})
@@ -88,15 +103,12 @@ func getBlobstoreAzureFromResourceData(d *schema.ResourceData) blobstore.Azure {
 			AccountName: bucketConfiguration["account_name"].(string),
 			Authentication: blobstore.AzureBucketConfigurationAuthentication{
 				AuthenticationMethod: blobstore.AzureAuthenticationMethod(authenticationConfig["authentication_method"].(string)),
+				AccountKey:           authenticationConfig["account_key"].(string),
 			},
 			ContainerName: bucketConfiguration["container_name"].(string),
 		},
 	}
 
-	if accountKey, ok := authenticationConfig["account_key"]; ok {
-		bs.BucketConfiguration.Authentication.AccountKey = accountKey.(string)
-	}
-
 	if _, ok := d.GetOk("soft_quota"); ok {
 		softQuotaList := d.Get("soft_quota").([]interface{})
 		softQuotaConfig := softQuotaList[0].(map[string]interface{})
@@ -121,6 +133,7 @@ func resourceBlobstoreAzureCreate(resourceData *schema.ResourceData, m interface
 
 	resourceData.SetId(bs.Name)
 	resourceData.Set("name", bs.Name)
+	resourceData.Set("account_key_hash", hashAzureAccountKey(bs.BucketConfiguration.Authentication.AccountKey))
 
 	return resourceBlobstoreAzureRead(resourceData, m)
 }
@@ -128,22 +141,20 @@ func resourceBlobstoreAzureCreate(resourceData *schema.ResourceData, m interface
 func resourceBlobstoreAzureRead(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return err
+	}
+
 	bs, err := nexusClient.BlobStore.Azure.Get(resourceData.Id())
 	log.Printf("[DEBUG] BlobStore:\n%+v\n", bs)
 	if err != nil {
 		return err
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := nexusClient.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(m, resourceData.Id())
 	if err != nil {
 		return err
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == bs.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	if bs == nil {
 		resourceData.SetId("")
@@ -180,12 +191,28 @@ func resourceBlobstoreAzureUpdate(resourceData *schema.ResourceData, m interface
 		return err
 	}
 
-	return nil
+	return resourceData.Set("account_key_hash", hashAzureAccountKey(bs.BucketConfiguration.Authentication.AccountKey))
 }
 
 func resourceBlobstoreAzureDelete(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	converted, err := isBlobstoreConvertedToGroup(m, resourceData.Id())
+	if err != nil {
+		return err
+	}
+	if converted {
+		log.Printf("[WARN] Blobstore %s was converted to a group, removing it from state without deleting the group", resourceData.Id())
+		resourceData.SetId("")
+		return nil
+	}
+
+	if !resourceData.Get("force_destroy").(bool) {
+		if err := checkBlobstoreUnused(m, resourceData.Id(), fmt.Sprintf("delete blobstore %s", resourceData.Id())); err != nil {
+			return err
+		}
+	}
+
 	if err := nexusClient.BlobStore.Azure.Delete(resourceData.Id()); err != nil {
 		return err
 	}
@@ -198,6 +225,35 @@ func resourceBlobstoreAzureDelete(resourceData *schema.ResourceData, m interface
 func resourceBlobstoreAzureExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	nexusClient := m.(*nexus.NexusClient)
 
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return false, err
+	}
+
 	bs, err := nexusClient.BlobStore.Azure.Get(resourceData.Id())
 	return bs != nil, err
 }
+
+// resourceBlobstoreAzureCustomizeDiff validates the soft quota, ensures
+// account_key is set if and only if the blobstore authenticates with it and
+// updates the blobstore if the hash of the key differs from the hash of the
+// key nexus was configured with
+func resourceBlobstoreAzureCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if err := blobstoreSchema.ValidateSoftQuota(diff); err != nil {
+		return err
+	}
+
+	methodKey := "bucket_configuration.0.authentication.0.authentication_method"
+	accountKeyKey := "bucket_configuration.0.authentication.0.account_key"
+	if !diff.NewValueKnown(methodKey) || !diff.NewValueKnown(accountKeyKey) {
+		return nil
+	}
+	accountKey := diff.Get(accountKeyKey).(string)
+	if err := validateAzureAuthentication(diff.Get(methodKey).(string), accountKey); err != nil {
+		return err
+	}
+
+	if hash := hashAzureAccountKey(accountKey); hash != diff.Get("account_key_hash").(string) {
+		return diff.SetNew("account_key_hash", hash)
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package blobstore_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -33,6 +36,8 @@ func TestAccResourceBlobstoreAzure(t *testing.T) {
 		},
 	}
 
+	accountKeyHash := sha256.Sum256([]byte(bs.BucketConfiguration.Authentication.AccountKey))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acceptance.AccPreCheck(t) },
 		Providers: acceptance.TestAccProviders,
@@ -47,6 +52,7 @@ func TestAccResourceBlobstoreAzure(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.container_name", bs.BucketConfiguration.ContainerName),
 					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.authentication.0.authentication_method", string(bs.BucketConfiguration.Authentication.AuthenticationMethod)),
 					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.authentication.0.account_key", bs.BucketConfiguration.Authentication.AccountKey),
+					resource.TestCheckResourceAttr(resourceName, "account_key_hash", hex.EncodeToString(accountKeyHash[:])),
 				),
 			},
 			{
@@ -54,13 +60,52 @@ func TestAccResourceBlobstoreAzure(t *testing.T) {
 				ImportState:             true,
 				ImportStateId:           bs.Name,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"bucket_configuration.0.authentication.0.account_key"},
+				ImportStateVerifyIgnore: []string{"account_key_hash", "bucket_configuration.0.authentication.0.account_key"},
+			},
+		},
+	})
+}
+
+func TestAccResourceBlobstoreAzureAuthenticationValidation(t *testing.T) {
+	bs := blobstore.Azure{
+		Name: fmt.Sprintf("test-blobstore-azure-%s", acctest.RandString(5)),
+		BucketConfiguration: blobstore.AzureBucketConfiguration{
+			AccountName: "terraformprovidernexus",
+			Authentication: blobstore.AzureBucketConfigurationAuthentication{
+				AuthenticationMethod: blobstore.AzureAuthenticationMethodAccountKey,
+			},
+			ContainerName: "acceptance",
+		},
+	}
+	withAccountKey := bs
+	withAccountKey.BucketConfiguration.Authentication = blobstore.AzureBucketConfigurationAuthentication{
+		AuthenticationMethod: "ENVIRONMENTVARIABLE",
+		AccountKey:           "test-key",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceBlobstoreTypeAzureConfig(bs),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key is required if authentication_method is ACCOUNTKEY"),
+			},
+			{
+				Config:      testAccResourceBlobstoreTypeAzureConfig(withAccountKey),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key can only be set if authentication_method is ACCOUNTKEY"),
 			},
 		},
 	})
 }
 
 func testAccResourceBlobstoreTypeAzureConfig(bs blobstore.Azure) string {
+	accountKey := ""
+	if bs.BucketConfiguration.Authentication.AccountKey != "" {
+		accountKey = fmt.Sprintf("account_key = %q", bs.BucketConfiguration.Authentication.AccountKey)
+	}
 	return fmt.Sprintf(`
 resource "nexus_blobstore_azure" "acceptance" {
 	name = "%s"
@@ -68,10 +113,10 @@ resource "nexus_blobstore_azure" "acceptance" {
 	bucket_configuration {
 		account_name = "%s"
 		authentication {
-			authentication_method			= "%s"
-			account_key	= "%s"
+			authentication_method = "%s"
+			%s
 		}
 		container_name = "%s"
 	}
-}`, bs.Name, bs.BucketConfiguration.AccountName, bs.BucketConfiguration.Authentication.AuthenticationMethod, bs.BucketConfiguration.Authentication.AccountKey, bs.BucketConfiguration.ContainerName)
+}`, bs.Name, bs.BucketConfiguration.AccountName, bs.BucketConfiguration.Authentication.AuthenticationMethod, accountKey, bs.BucketConfiguration.ContainerName)
 }
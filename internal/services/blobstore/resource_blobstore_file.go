@@ -1,6 +1,7 @@
 package blobstore
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -15,24 +16,27 @@ func ResourceBlobstoreFile() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus file blobstore.",
 
-		Create: resourceBlobstoreFileCreate,
-		Read:   resourceBlobstoreFileRead,
-		Update: resourceBlobstoreFileUpdate,
-		Delete: resourceBlobstoreFileDelete,
-		Exists: resourceBlobstoreFileExists,
+		Create:        resourceBlobstoreFileCreate,
+		Read:          resourceBlobstoreFileRead,
+		Update:        resourceBlobstoreFileUpdate,
+		Delete:        resourceBlobstoreFileDelete,
+		Exists:        resourceBlobstoreFileExists,
+		CustomizeDiff: resourceBlobstoreFileCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importBlobstoreState,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"id":   common.ResourceID,
 			"name": blobstoreSchema.ResourceName,
 			"path": {
-				Description: "The path to the blobstore contents. This can be an absolute path to anywhere on the system nxrm has access to or it can be a path relative to the sonatype-work directory",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The path to the blobstore contents. This can be an absolute path to anywhere on the system nxrm has access to or it can be a path relative to the sonatype-work directory. A relative path does not differ from the absolute path nexus resolves it to",
+				DiffSuppressFunc: suppressBlobstoreFilePathDiff,
+				Type:             schema.TypeString,
+				Optional:         true,
 			},
 			"available_space_in_bytes": blobstoreSchema.ResourceAvailableSpaceInBytes,
+			"force_destroy":            blobstoreSchema.ResourceForceDestroy,
 			"blob_count":               blobstoreSchema.ResourceBlobCount,
 			"soft_quota":               blobstoreSchema.ResourceSoftQuota,
 			"total_size_in_bytes":      blobstoreSchema.ResourceTotalSizeInBytes,
@@ -46,7 +50,7 @@ func getBlobstoreFileFromResourceData(resourceData *schema.ResourceData) blobsto
 	}
 
 	if _, ok := resourceData.GetOk("path"); ok {
-		bs.Path = resourceData.Get("path").(string)
+		bs.Path = normalizeBlobstoreFilePath(resourceData.Get("path").(string))
 	}
 
 	if _, ok := resourceData.GetOk("soft_quota"); ok {
@@ -82,22 +86,20 @@ func resourceBlobstoreFileCreate(resourceData *schema.ResourceData, m interface{
 func resourceBlobstoreFileRead(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return err
+	}
+
 	bs, err := nexusClient.BlobStore.File.Get(resourceData.Id())
 	log.Printf("[DEBUG] BlobStore:\n%+v\n", bs)
 	if err != nil {
 		return err
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := nexusClient.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(m, resourceData.Id())
 	if err != nil {
 		return err
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == bs.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	if bs == nil {
 		resourceData.SetId("")
@@ -143,6 +145,22 @@ func resourceBlobstoreFileUpdate(resourceData *schema.ResourceData, m interface{
 func resourceBlobstoreFileDelete(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	converted, err := isBlobstoreConvertedToGroup(m, resourceData.Id())
+	if err != nil {
+		return err
+	}
+	if converted {
+		log.Printf("[WARN] Blobstore %s was converted to a group, removing it from state without deleting the group", resourceData.Id())
+		resourceData.SetId("")
+		return nil
+	}
+
+	if !resourceData.Get("force_destroy").(bool) {
+		if err := checkBlobstoreUnused(m, resourceData.Id(), fmt.Sprintf("delete blobstore %s", resourceData.Id())); err != nil {
+			return err
+		}
+	}
+
 	if err := nexusClient.BlobStore.File.Delete(resourceData.Id()); err != nil {
 		return err
 	}
@@ -155,6 +173,15 @@ func resourceBlobstoreFileDelete(resourceData *schema.ResourceData, m interface{
 func resourceBlobstoreFileExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	nexusClient := m.(*nexus.NexusClient)
 
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return false, err
+	}
+
 	bs, err := nexusClient.BlobStore.File.Get(resourceData.Id())
 	return bs != nil, err
 }
+
+// resourceBlobstoreFileCustomizeDiff validates the soft quota
+func resourceBlobstoreFileCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	return blobstoreSchema.ValidateSoftQuota(diff)
+}
@@ -3,6 +3,7 @@ package blobstore_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -78,3 +79,109 @@ func TestAccResourceBlobstoreFile(t *testing.T) {
 		},
 	})
 }
+
+func testAccResourceBlobstoreFileRepositoryConfig(blobstoreName string, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "%s"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+`, repositoryName, blobstoreName)
+}
+
+func TestAccResourceBlobstoreFileInUse(t *testing.T) {
+	blobstoreName := fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))
+	repositoryName := fmt.Sprintf("test-repo-%s", acctest.RandString(5))
+	blobstoreConfig := testAccResourceBlobstoreFileConfig(blobstore.File{
+		Name: blobstoreName,
+		Path: "/nexus-data/acceptance",
+	})
+	repositoryConfig := testAccResourceBlobstoreFileRepositoryConfig(blobstoreName, repositoryName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: blobstoreConfig,
+			},
+			{
+				Config: blobstoreConfig + repositoryConfig,
+			},
+			{
+				// the repository is created after the blobstore without a reference,
+				// so removing the blobstore from the config must fail
+				Config:      repositoryConfig,
+				ExpectError: regexp.MustCompile(fmt.Sprintf("refusing to delete blobstore %s because it is used by the repositories %s", blobstoreName, repositoryName)),
+			},
+			{
+				Config: blobstoreConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_blobstore_file.acceptance", "force_destroy", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBlobstoreFileRelativePath(t *testing.T) {
+	resourceName := "nexus_blobstore_file.acceptance"
+
+	bs := blobstore.File{
+		Name: fmt.Sprintf("test-blobstore-%s", acctest.RandString(5)),
+	}
+	// redundant separators are removed and nexus may return the absolute path
+	bs.Path = fmt.Sprintf("./%s/", bs.Name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreFileConfig(bs),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", bs.Name),
+					resource.TestMatchResourceAttr(resourceName, "path", regexp.MustCompile("(^|/)"+bs.Name+"$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBlobstoreFileSoftQuotaLimit(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "nexus_blobstore_file" "acceptance" {
+	name = "%s"
+	soft_quota {
+		limit = 1000
+		type  = "spaceUsedQuota"
+	}
+}`, fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the limit of soft_quota must be at least 1000000 bytes, got 1000"),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "nexus_blobstore_file" "acceptance" {
+	name = "%s"
+	soft_quota {
+		limit = 1000000
+		type  = "spaceFreeQuota"
+	}
+}`, fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the type of soft_quota must be spaceRemainingQuota or spaceUsedQuota, got spaceFreeQuota"),
+			},
+		},
+	})
+}
@@ -0,0 +1,268 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	blobstoreSchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceBlobstoreGoogle() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to create a Nexus Google Cloud Storage blobstore.`,
+
+		Create:        resourceBlobstoreGoogleCreate,
+		Read:          resourceBlobstoreGoogleRead,
+		Update:        resourceBlobstoreGoogleUpdate,
+		Delete:        resourceBlobstoreGoogleDelete,
+		Exists:        resourceBlobstoreGoogleExists,
+		CustomizeDiff: resourceBlobstoreGoogleCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBlobstoreState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id":                  common.ResourceID,
+			"name":                blobstoreSchema.ResourceName,
+			"force_destroy":       blobstoreSchema.ResourceForceDestroy,
+			"blob_count":          blobstoreSchema.ResourceBlobCount,
+			"soft_quota":          blobstoreSchema.ResourceCloudSoftQuota,
+			"total_size_in_bytes": blobstoreSchema.ResourceTotalSizeInBytes,
+			"bucket_configuration": {
+				Description: "The Google Cloud Storage bucket configuration",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Description: "The Google Cloud Storage bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the bucket, which is created if it does not exist",
+										Required:    true,
+										Type:        schema.TypeString,
+									},
+									"region": {
+										Description: "The region of the bucket, e.g. `us-central1`",
+										Required:    true,
+										Type:        schema.TypeString,
+									},
+									"prefix": {
+										Description: "The prefix of the objects of the blobstore in the bucket",
+										Optional:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							MaxItems: 1,
+							Required: true,
+							Type:     schema.TypeList,
+						},
+						"bucket_security": {
+							Description: "The authentication against Google Cloud Storage, uses the application default credentials of nexus if unset",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_method": {
+										Description:  "How nexus authenticates. Possible values: `accountKey` and `applicationDefault`",
+										Required:     true,
+										Type:         schema.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{api.GoogleAuthenticationMethodAccountKey, api.GoogleAuthenticationMethodApplicationDefault}, false),
+									},
+									"account_key": {
+										Description: "The content of the JSON credential file of a service account. Required if `authentication_method` is `accountKey`",
+										Optional:    true,
+										Sensitive:   true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							MaxItems: 1,
+							Optional: true,
+							Type:     schema.TypeList,
+						},
+					},
+				},
+				MaxItems: 1,
+				Required: true,
+				Type:     schema.TypeList,
+			},
+		},
+	}
+}
+
+func getBlobstoreGoogleFromResourceData(d *schema.ResourceData) api.GoogleBlobstore {
+	bucketConfiguration := d.Get("bucket_configuration").([]interface{})[0].(map[string]interface{})
+	bucket := bucketConfiguration["bucket"].([]interface{})[0].(map[string]interface{})
+
+	bs := api.GoogleBlobstore{
+		Name: d.Get("name").(string),
+		BucketConfiguration: api.GoogleBlobstoreBucketConfiguration{
+			Bucket: api.GoogleBlobstoreBucket{
+				Name:   bucket["name"].(string),
+				Prefix: bucket["prefix"].(string),
+				Region: bucket["region"].(string),
+			},
+		},
+	}
+
+	bucketSecurityList := bucketConfiguration["bucket_security"].([]interface{})
+	if len(bucketSecurityList) > 0 && bucketSecurityList[0] != nil {
+		bucketSecurity := bucketSecurityList[0].(map[string]interface{})
+
+		bs.BucketConfiguration.BucketSecurity = &api.GoogleBlobstoreBucketSecurity{
+			AuthenticationMethod: bucketSecurity["authentication_method"].(string),
+			AccountKey:           bucketSecurity["account_key"].(string),
+		}
+	}
+
+	if _, ok := d.GetOk("soft_quota"); ok {
+		softQuotaList := d.Get("soft_quota").([]interface{})
+		softQuotaConfig := softQuotaList[0].(map[string]interface{})
+
+		bs.SoftQuota = &blobstore.SoftQuota{
+			Limit: int64(softQuotaConfig["limit"].(int)),
+			Type:  softQuotaConfig["type"].(string),
+		}
+	}
+
+	return bs
+}
+
+func resourceBlobstoreGoogleCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	bs := getBlobstoreGoogleFromResourceData(resourceData)
+	if err := client.BlobstoreGoogle.Create(&bs); err != nil {
+		return err
+	}
+
+	resourceData.SetId(bs.Name)
+	resourceData.Set("name", bs.Name)
+
+	return resourceBlobstoreGoogleRead(resourceData, m)
+}
+
+func resourceBlobstoreGoogleRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return err
+	}
+
+	bs, err := client.BlobstoreGoogle.Get(resourceData.Id())
+	log.Printf("[DEBUG] BlobStore:\n%+v\n", bs)
+	if err != nil {
+		return err
+	}
+
+	if bs == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	genericBlobstoreInformation, err := getGenericBlobstore(m, resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("name", bs.Name); err != nil {
+		return err
+	}
+	if err := resourceData.Set("blob_count", genericBlobstoreInformation.BlobCount); err != nil {
+		return err
+	}
+	if err := resourceData.Set("total_size_in_bytes", genericBlobstoreInformation.TotalSizeInBytes); err != nil {
+		return err
+	}
+	if err := resourceData.Set("bucket_configuration", flattenGoogleBucketConfiguration(&bs.BucketConfiguration, resourceData)); err != nil {
+		return fmt.Errorf("error reading bucket configuration: %s", err)
+	}
+
+	if bs.SoftQuota != nil {
+		if err := resourceData.Set("soft_quota", flattenSoftQuota(bs.SoftQuota)); err != nil {
+			return fmt.Errorf("error reading soft quota: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceBlobstoreGoogleUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	bs := getBlobstoreGoogleFromResourceData(resourceData)
+	if err := client.BlobstoreGoogle.Update(resourceData.Id(), &bs); err != nil {
+		return err
+	}
+
+	return resourceBlobstoreGoogleRead(resourceData, m)
+}
+
+func resourceBlobstoreGoogleDelete(resourceData *schema.ResourceData, m interface{}) error {
+	nexusClient := m.(*nexus.NexusClient)
+
+	converted, err := isBlobstoreConvertedToGroup(m, resourceData.Id())
+	if err != nil {
+		return err
+	}
+	if converted {
+		log.Printf("[WARN] Blobstore %s was converted to a group, removing it from state without deleting the group", resourceData.Id())
+		resourceData.SetId("")
+		return nil
+	}
+
+	if !resourceData.Get("force_destroy").(bool) {
+		if err := checkBlobstoreUnused(m, resourceData.Id(), fmt.Sprintf("delete blobstore %s", resourceData.Id())); err != nil {
+			return err
+		}
+	}
+
+	if err := nexusClient.BlobStore.Delete(resourceData.Id()); err != nil {
+		return err
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBlobstoreGoogleExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := api.FromMeta(m)
+
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return false, err
+	}
+
+	bs, err := client.BlobstoreGoogle.Get(resourceData.Id())
+	return bs != nil, err
+}
+
+// resourceBlobstoreGoogleCustomizeDiff validates the soft quota and ensures
+// account_key is set if the blobstore authenticates with it
+func resourceBlobstoreGoogleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if err := blobstoreSchema.ValidateSoftQuota(diff); err != nil {
+		return err
+	}
+
+	methodKey := "bucket_configuration.0.bucket_security.0.authentication_method"
+	accountKeyKey := "bucket_configuration.0.bucket_security.0.account_key"
+	if !diff.NewValueKnown(methodKey) || !diff.NewValueKnown(accountKeyKey) {
+		return nil
+	}
+	return validateBlobstoreGoogle(diff.Get(methodKey).(string), diff.Get(accountKeyKey).(string))
+}
+
+func validateBlobstoreGoogle(method string, accountKey string) error {
+	if method == api.GoogleAuthenticationMethodAccountKey && accountKey == "" {
+		return fmt.Errorf("account_key is required if authentication_method is %s", api.GoogleAuthenticationMethodAccountKey)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func testAccResourceBlobstoreGoogle(name string) api.GoogleBlobstore {
+	return api.GoogleBlobstore{
+		Name: name,
+		SoftQuota: &blobstore.SoftQuota{
+			Limit: 1024000000,
+			Type:  "spaceUsedQuota",
+		},
+		BucketConfiguration: api.GoogleBlobstoreBucketConfiguration{
+			Bucket: api.GoogleBlobstoreBucket{
+				Name:   tools.GetEnv("GOOGLE_STORAGE_BUCKET", "terraform-provider-nexus"),
+				Prefix: name,
+				Region: "us-central1",
+			},
+			BucketSecurity: &api.GoogleBlobstoreBucketSecurity{
+				AuthenticationMethod: api.GoogleAuthenticationMethodAccountKey,
+				AccountKey:           tools.GetEnv("GOOGLE_STORAGE_ACCOUNT_KEY", "{}"),
+			},
+		},
+	}
+}
+
+func TestAccResourceBlobstoreGoogle(t *testing.T) {
+	if tools.GetEnv("SKIP_GOOGLE_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus blobstore for Google Cloud Storage tests")
+	}
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resourceName := "nexus_blobstore_google.acceptance"
+	bs := testAccResourceBlobstoreGoogle(fmt.Sprintf("test-blobstore-google-%s", acctest.RandString(5)))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreGoogleConfig(bs),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", bs.Name),
+					resource.TestCheckResourceAttr(resourceName, "name", bs.Name),
+					resource.TestCheckResourceAttrSet(resourceName, "blob_count"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_size_in_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.0.limit", strconv.FormatInt(bs.SoftQuota.Limit, 10)),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.0.type", bs.SoftQuota.Type),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket.0.name", bs.BucketConfiguration.Bucket.Name),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket.0.prefix", bs.BucketConfiguration.Bucket.Prefix),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket.0.region", bs.BucketConfiguration.Bucket.Region),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket_security.0.authentication_method", bs.BucketConfiguration.BucketSecurity.AuthenticationMethod),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           bs.Name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bucket_configuration.0.bucket_security.0.account_key"},
+			},
+		},
+	})
+}
+
+func TestAccResourceBlobstoreGoogleAuthenticationValidation(t *testing.T) {
+	bs := testAccResourceBlobstoreGoogle(fmt.Sprintf("test-blobstore-google-%s", acctest.RandString(5)))
+	bs.BucketConfiguration.BucketSecurity.AccountKey = ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceBlobstoreGoogleConfig(bs),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("account_key is required if authentication_method is accountKey"),
+			},
+		},
+	})
+}
+
+func testAccResourceBlobstoreGoogleConfig(bs api.GoogleBlobstore) string {
+	return fmt.Sprintf(`
+resource "nexus_blobstore_google" "acceptance" {
+	name = "%s"
+
+	bucket_configuration {
+		bucket {
+			name   = "%s"
+			prefix = "%s"
+			region = "%s"
+		}
+
+		bucket_security {
+			authentication_method = "%s"
+			account_key           = %q
+		}
+	}
+
+	soft_quota {
+		limit = %d
+		type  = "%s"
+	}
+}`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Prefix, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.BucketSecurity.AuthenticationMethod, bs.BucketConfiguration.BucketSecurity.AccountKey, bs.SoftQuota.Limit, bs.SoftQuota.Type)
+}
@@ -1,6 +1,7 @@
 package blobstore
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
 	blobstoreSchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
@@ -17,22 +19,32 @@ func ResourceBlobstoreGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: `~> PRO Feature
 
-Use this resource to create a Nexus group blobstore.`,
+Use this resource to create a Nexus group blobstore.
 
-		Create: resourceBlobstoreGroupCreate,
-		Read:   resourceBlobstoreGroupRead,
-		Update: resourceBlobstoreGroupUpdate,
-		Delete: resourceBlobstoreGroupDelete,
-		Exists: resourceBlobstoreGroupExists,
+An existing blobstore can be converted into a group with ` + "`converted_blobstore_name`" + `, e.g. to add capacity without moving its repositories. The group takes over the name of the blobstore and the blobstore is renamed and becomes a member of the group. A resource managing the blobstore under its old name fails on the next refresh with an error, so replace it by a ` + "`removed`" + ` block in the same apply or remove it from the state with ` + "`terraform state rm`" + `. The renamed blobstore can be imported under its new name, see the example below.`,
+
+		Create:        resourceBlobstoreGroupCreate,
+		Read:          resourceBlobstoreGroupRead,
+		Update:        resourceBlobstoreGroupUpdate,
+		Delete:        resourceBlobstoreGroupDelete,
+		Exists:        resourceBlobstoreGroupExists,
+		CustomizeDiff: resourceBlobstoreGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importBlobstoreState,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"id":                       common.ResourceID,
 			"name":                     blobstoreSchema.ResourceName,
 			"available_space_in_bytes": blobstoreSchema.ResourceAvailableSpaceInBytes,
+			"force_destroy":            blobstoreSchema.ResourceForceDestroy,
 			"blob_count":               blobstoreSchema.ResourceBlobCount,
+			"converted_blobstore_name": {
+				Description:      "Create the group by converting the existing blobstore with the `name` of the group instead of creating a new blobstore. The existing blobstore is renamed to this name and must be one of the `members`. Only used when the group is created, later changes are ignored",
+				DiffSuppressFunc: suppressConvertedBlobstoreNameDiff,
+				Optional:         true,
+				Type:             schema.TypeString,
+			},
 			"fill_policy": {
 				Description:  "The policy how to fill the members. Possible values: `roundRobin` or `writeToFirst`",
 				Type:         schema.TypeString,
@@ -76,9 +88,22 @@ func getBlobstoreGroupFromResourceData(resourceData *schema.ResourceData) blobst
 func resourceBlobstoreGroupCreate(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	client := api.FromMeta(m)
+
 	bs := getBlobstoreGroupFromResourceData(resourceData)
 
-	if err := nexusClient.BlobStore.Group.Create(&bs); err != nil {
+	if convertedName, ok := resourceData.GetOk("converted_blobstore_name"); ok {
+		if err := client.BlobstoreGroup.Convert(bs.Name, convertedName.(string)); err != nil {
+			return err
+		}
+		// the group exists now, keep it in the state if the update fails
+		resourceData.SetId(bs.Name)
+		// the conversion only sets the renamed blobstore as member, so apply
+		// the remaining configuration
+		if err := nexusClient.BlobStore.Group.Update(bs.Name, &bs); err != nil {
+			return err
+		}
+	} else if err := nexusClient.BlobStore.Group.Create(&bs); err != nil {
 		return err
 	}
 
@@ -100,16 +125,10 @@ func resourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}
 		return err
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := nexusClient.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(m, resourceData.Id())
 	if err != nil {
 		return err
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == bs.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	if bs == nil {
 		resourceData.SetId("")
@@ -147,6 +166,15 @@ func resourceBlobstoreGroupRead(resourceData *schema.ResourceData, m interface{}
 func resourceBlobstoreGroupUpdate(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	if resourceData.HasChange("members") && !resourceData.Get("force_destroy").(bool) {
+		oldMembers, newMembers := resourceData.GetChange("members")
+		for _, member := range tools.ConvertStringSet(oldMembers.(*schema.Set).Difference(newMembers.(*schema.Set))) {
+			if err := checkBlobstoreGroupMemberEmpty(m, resourceData.Id(), member); err != nil {
+				return err
+			}
+		}
+	}
+
 	bs := getBlobstoreGroupFromResourceData(resourceData)
 	if err := nexusClient.BlobStore.Group.Update(resourceData.Id(), &bs); err != nil {
 		return err
@@ -158,6 +186,12 @@ func resourceBlobstoreGroupUpdate(resourceData *schema.ResourceData, m interface
 func resourceBlobstoreGroupDelete(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	if !resourceData.Get("force_destroy").(bool) {
+		if err := checkBlobstoreUnused(m, resourceData.Id(), fmt.Sprintf("delete blobstore %s", resourceData.Id())); err != nil {
+			return err
+		}
+	}
+
 	if err := nexusClient.BlobStore.Group.Delete(resourceData.Id()); err != nil {
 		return err
 	}
@@ -173,3 +207,28 @@ func resourceBlobstoreGroupExists(resourceData *schema.ResourceData, m interface
 	bs, err := nexusClient.BlobStore.Group.Get(resourceData.Id())
 	return bs != nil, err
 }
+
+// suppressConvertedBlobstoreNameDiff ignores changes of
+// converted_blobstore_name once the group exists, it is only used on create
+func suppressConvertedBlobstoreNameDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// resourceBlobstoreGroupCustomizeDiff validates the soft quota and ensures a
+// blobstore converted into the group remains a member of it
+func resourceBlobstoreGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if err := blobstoreSchema.ValidateSoftQuota(diff); err != nil {
+		return err
+	}
+	if diff.Id() != "" {
+		return nil
+	}
+	convertedName, ok := diff.GetOk("converted_blobstore_name")
+	if !ok || !diff.NewValueKnown("members") {
+		return nil
+	}
+	if !diff.Get("members").(*schema.Set).Contains(convertedName) {
+		return fmt.Errorf("members of group %s must contain the converted blobstore %s", diff.Get("name").(string), convertedName.(string))
+	}
+	return nil
+}
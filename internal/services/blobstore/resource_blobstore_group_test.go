@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
@@ -100,3 +101,76 @@ func TestAccResourceBlobstoreGroup(t *testing.T) {
 		},
 	})
 }
+
+func testAccResourceBlobstoreGroupConvertConfig(name string, convertedName string, member string) string {
+	return fmt.Sprintf(`
+resource "nexus_blobstore_group" "acceptance" {
+	name                     = "%s"
+	fill_policy              = "writeToFirst"
+	converted_blobstore_name = "%s"
+	members = [
+		%s
+	]
+}
+`, name, convertedName, member)
+}
+
+func TestAccResourceBlobstoreGroupConvert(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resourceName := "nexus_blobstore_group.acceptance"
+
+	original := blobstore.File{
+		Name: fmt.Sprintf("test-blobstore-%s", acctest.RandString(5)),
+		Path: fmt.Sprintf("/nexus-data/test-file-%s", acctest.RandString(5)),
+	}
+	convertedName := original.Name + "-original"
+	converted := blobstore.File{
+		Name: convertedName,
+		Path: original.Path,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the original blobstore is not managed by terraform, a
+				// resource managing it would fail after the conversion
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*nexus.NexusClient)
+					if err := client.BlobStore.File.Create(&original); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceBlobstoreGroupConvertConfig(original.Name, convertedName, strconv.Quote(convertedName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", original.Name),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "members.0", convertedName),
+				),
+			},
+			{
+				// changes of converted_blobstore_name after create are ignored
+				Config:   testAccResourceBlobstoreGroupConvertConfig(original.Name, original.Name+"-other", strconv.Quote(convertedName)),
+				PlanOnly: true,
+			},
+			{
+				Config:             testAccResourceBlobstoreFileConfig(converted) + testAccResourceBlobstoreGroupConvertConfig(original.Name, convertedName, "nexus_blobstore_file.acceptance.name"),
+				ResourceName:       "nexus_blobstore_file.acceptance",
+				ImportState:        true,
+				ImportStateId:      convertedName,
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccResourceBlobstoreFileConfig(converted) + testAccResourceBlobstoreGroupConvertConfig(original.Name, convertedName, "nexus_blobstore_file.acceptance.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_blobstore_file.acceptance", "id", convertedName),
+					resource.TestCheckResourceAttr("nexus_blobstore_file.acceptance", "path", original.Path),
+				),
+			},
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package blobstore
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -9,6 +10,7 @@ import (
 	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
 	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
 	blobstoreSchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
 )
@@ -17,20 +19,22 @@ func ResourceBlobstoreS3() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus S3 blobstore.",
 
-		Create: resourceBlobstoreS3Create,
-		Read:   resourceBlobstoreS3Read,
-		Update: resourceBlobstoreS3Update,
-		Delete: resourceBlobstoreS3Delete,
-		Exists: resourceBlobstoreS3Exists,
+		Create:        resourceBlobstoreS3Create,
+		Read:          resourceBlobstoreS3Read,
+		Update:        resourceBlobstoreS3Update,
+		Delete:        resourceBlobstoreS3Delete,
+		Exists:        resourceBlobstoreS3Exists,
+		CustomizeDiff: resourceBlobstoreS3CustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importBlobstoreState,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"id":                  common.ResourceID,
 			"name":                blobstoreSchema.ResourceName,
+			"force_destroy":       blobstoreSchema.ResourceForceDestroy,
 			"blob_count":          blobstoreSchema.ResourceBlobCount,
-			"soft_quota":          blobstoreSchema.ResourceSoftQuota,
+			"soft_quota":          blobstoreSchema.ResourceCloudSoftQuota,
 			"total_size_in_bytes": blobstoreSchema.ResourceTotalSizeInBytes,
 			"bucket_configuration": {
 				Description: "The S3 bucket configuration.",
@@ -57,9 +61,10 @@ func ResourceBlobstoreS3() *schema.Resource {
 										Type:        schema.TypeString,
 									},
 									"max_connection_pool_size": {
-										Description: "Setting this value will override the default connection pool size of Nexus of the s3 client for this blobstore.",
-										Optional:    true,
-										Type:        schema.TypeInt,
+										Description:  "Setting this value will override the default connection pool size of Nexus of the s3 client for this blobstore.",
+										Optional:     true,
+										Type:         schema.TypeInt,
+										ValidateFunc: validation.IntAtLeast(1),
 									},
 								},
 							},
@@ -129,6 +134,30 @@ func ResourceBlobstoreS3() *schema.Resource {
 							Optional: true,
 							Type:     schema.TypeList,
 						},
+						"failover_buckets": {
+							Description: "PRO Feature: Buckets in other regions nexus replicates the blobs to and fails over to if the region of the bucket is unavailable",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Description: "The AWS region of the failover bucket",
+										Required:    true,
+										Type:        schema.TypeString,
+									},
+									"bucket_name": {
+										Description: "The name of the failover bucket",
+										Required:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Optional: true,
+							Type:     schema.TypeList,
+						},
+						"active_region": {
+							Description: "The region of the bucket nexus currently uses",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
 						"encryption": {
 							Description: "Additional bucket encryption configurations",
 							Elem: &schema.Resource{
@@ -160,27 +189,29 @@ func ResourceBlobstoreS3() *schema.Resource {
 	}
 }
 
-func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
+func getBlobstoreS3FromResourceData(d *schema.ResourceData) api.S3Blobstore {
 	bucketConfigurationList := d.Get("bucket_configuration").([]interface{})
 	bucketConfiguration := bucketConfigurationList[0].(map[string]interface{})
 	bucketList := bucketConfiguration["bucket"].([]interface{})
 	bucket := bucketList[0].(map[string]interface{})
 
-	bs := blobstore.S3{
+	bs := api.S3Blobstore{
 		Name: d.Get("name").(string),
-		BucketConfiguration: blobstore.S3BucketConfiguration{
-			Bucket: blobstore.S3Bucket{
-				Expiration: int32(bucket["expiration"].(int)),
-				Name:       bucket["name"].(string),
-				Prefix:     bucket["prefix"].(string),
-				Region:     bucket["region"].(string),
+		BucketConfiguration: api.S3BlobstoreBucketConfiguration{
+			S3BucketConfiguration: blobstore.S3BucketConfiguration{
+				Bucket: blobstore.S3Bucket{
+					Expiration: int32(bucket["expiration"].(int)),
+					Name:       bucket["name"].(string),
+					Prefix:     bucket["prefix"].(string),
+					Region:     bucket["region"].(string),
+				},
 			},
 		},
 	}
 
 	if _, ok := bucketConfiguration["advanced_bucket_connection"]; ok {
 		advancedBucketConfigurationList := bucketConfiguration["advanced_bucket_connection"].([]interface{})
-		if len(advancedBucketConfigurationList) > 0 {
+		if len(advancedBucketConfigurationList) > 0 && advancedBucketConfigurationList[0] != nil {
 			advancedBucketConfiguration := advancedBucketConfigurationList[0].(map[string]interface{})
 
 			bs.BucketConfiguration.AdvancedBucketConnection = &blobstore.S3AdvancedBucketConnection{
@@ -188,6 +219,10 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 				SignerType:     advancedBucketConfiguration["signer_type"].(string),
 				ForcePathStyle: tools.GetBoolPointer(advancedBucketConfiguration["force_path_style"].(bool)),
 			}
+			if maxConnectionPoolSize := advancedBucketConfiguration["max_connection_pool_size"].(int); maxConnectionPoolSize > 0 {
+				size := int32(maxConnectionPoolSize)
+				bs.BucketConfiguration.AdvancedBucketConnection.MaxConnectionPoolSize = &size
+			}
 		}
 	}
 
@@ -207,7 +242,7 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 
 	if _, ok := bucketConfiguration["encryption"]; ok {
 		encryptionList := bucketConfiguration["encryption"].([]interface{})
-		if len(encryptionList) > 0 {
+		if len(encryptionList) > 0 && encryptionList[0] != nil {
 			encryption := encryptionList[0].(map[string]interface{})
 
 			bs.BucketConfiguration.Encryption = &blobstore.S3Encryption{
@@ -217,6 +252,16 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 		}
 	}
 
+	if failoverBucketList, ok := bucketConfiguration["failover_buckets"].([]interface{}); ok {
+		for _, failoverBucket := range failoverBucketList {
+			failoverBucketConfig := failoverBucket.(map[string]interface{})
+			bs.BucketConfiguration.FailoverBuckets = append(bs.BucketConfiguration.FailoverBuckets, api.S3FailoverBucket{
+				Region:     failoverBucketConfig["region"].(string),
+				BucketName: failoverBucketConfig["bucket_name"].(string),
+			})
+		}
+	}
+
 	if _, ok := d.GetOk("soft_quota"); ok {
 		softQuotaList := d.Get("soft_quota").([]interface{})
 		softQuotaConfig := softQuotaList[0].(map[string]interface{})
@@ -231,11 +276,11 @@ func getBlobstoreS3FromResourceData(d *schema.ResourceData) blobstore.S3 {
 }
 
 func resourceBlobstoreS3Create(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	client := api.FromMeta(m)
 
 	bs := getBlobstoreS3FromResourceData(resourceData)
 
-	if err := nexusClient.BlobStore.S3.Create(&bs); err != nil {
+	if err := client.BlobstoreS3.Create(&bs); err != nil {
 		return err
 	}
 
@@ -246,30 +291,28 @@ func resourceBlobstoreS3Create(resourceData *schema.ResourceData, m interface{})
 }
 
 func resourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	client := api.FromMeta(m)
 
-	bs, err := nexusClient.BlobStore.S3.Get(resourceData.Id())
-	log.Printf("[DEBUG] BlobStore:\n%+v\n", bs)
-	if err != nil {
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
 		return err
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := nexusClient.BlobStore.List()
+	bs, err := client.BlobstoreS3.Get(resourceData.Id())
+	log.Printf("[DEBUG] BlobStore:\n%+v\n", bs)
 	if err != nil {
 		return err
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == bs.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	if bs == nil {
 		resourceData.SetId("")
 		return nil
 	}
 
+	genericBlobstoreInformation, err := getGenericBlobstore(m, resourceData.Id())
+	if err != nil {
+		return err
+	}
+
 	if err := resourceData.Set("name", bs.Name); err != nil {
 		return err
 	}
@@ -293,19 +336,35 @@ func resourceBlobstoreS3Read(resourceData *schema.ResourceData, m interface{}) e
 }
 
 func resourceBlobstoreS3Update(resourceData *schema.ResourceData, m interface{}) error {
-	nexusClient := m.(*nexus.NexusClient)
+	client := api.FromMeta(m)
 
 	bs := getBlobstoreS3FromResourceData(resourceData)
-	if err := nexusClient.BlobStore.S3.Update(resourceData.Id(), &bs); err != nil {
+	if err := client.BlobstoreS3.Update(resourceData.Id(), &bs); err != nil {
 		return err
 	}
 
-	return nil
+	return resourceBlobstoreS3Read(resourceData, m)
 }
 
 func resourceBlobstoreS3Delete(resourceData *schema.ResourceData, m interface{}) error {
 	nexusClient := m.(*nexus.NexusClient)
 
+	converted, err := isBlobstoreConvertedToGroup(m, resourceData.Id())
+	if err != nil {
+		return err
+	}
+	if converted {
+		log.Printf("[WARN] Blobstore %s was converted to a group, removing it from state without deleting the group", resourceData.Id())
+		resourceData.SetId("")
+		return nil
+	}
+
+	if !resourceData.Get("force_destroy").(bool) {
+		if err := checkBlobstoreUnused(m, resourceData.Id(), fmt.Sprintf("delete blobstore %s", resourceData.Id())); err != nil {
+			return err
+		}
+	}
+
 	if err := nexusClient.BlobStore.S3.Delete(resourceData.Id()); err != nil {
 		return err
 	}
@@ -316,8 +375,17 @@ func resourceBlobstoreS3Delete(resourceData *schema.ResourceData, m interface{})
 }
 
 func resourceBlobstoreS3Exists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	nexusClient := m.(*nexus.NexusClient)
+	client := api.FromMeta(m)
+
+	if err := checkBlobstoreNotConverted(m, resourceData.Id()); err != nil {
+		return false, err
+	}
 
-	bs, err := nexusClient.BlobStore.S3.Get(resourceData.Id())
+	bs, err := client.BlobstoreS3.Get(resourceData.Id())
 	return bs != nil, err
 }
+
+// resourceBlobstoreS3CustomizeDiff validates the soft quota
+func resourceBlobstoreS3CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	return blobstoreSchema.ValidateSoftQuota(diff)
+}
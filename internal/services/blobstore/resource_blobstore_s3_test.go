@@ -12,17 +12,11 @@ import (
 	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
 )
 
-func TestAccResourceBlobstoreS3(t *testing.T) {
-	if tools.GetEnv("SKIP_S3_TESTS", "false") == "true" {
-		t.Skip("Skipping S3 tests")
-	}
-
-	resourceName := "nexus_blobstore_s3.acceptance"
-	awsAccessKeyID := tools.GetEnv("AWS_ACCESS_KEY_ID", "")
-	awsSecretAccessKey := tools.GetEnv("AWS_SECRET_ACCESS_KEY", "")
+func testAccResourceBlobstoreS3() blobstore.S3 {
 	forcePathStyle := true
+	maxConnectionPoolSize := int32(25)
 
-	bs := blobstore.S3{
+	return blobstore.S3{
 		Name: fmt.Sprintf("test-blobstore-s3-%s", acctest.RandString(5)),
 		BucketConfiguration: blobstore.S3BucketConfiguration{
 			Bucket: blobstore.S3Bucket{
@@ -31,11 +25,24 @@ func TestAccResourceBlobstoreS3(t *testing.T) {
 				Expiration: 0,
 			},
 			AdvancedBucketConnection: &blobstore.S3AdvancedBucketConnection{
-				Endpoint:       tools.GetEnv("AWS_ENDPOINT", ""),
-				ForcePathStyle: &forcePathStyle,
+				Endpoint:              tools.GetEnv("AWS_ENDPOINT", ""),
+				SignerType:            "AWSS3V4SignerType",
+				ForcePathStyle:        &forcePathStyle,
+				MaxConnectionPoolSize: &maxConnectionPoolSize,
 			},
 		},
 	}
+}
+
+func TestAccResourceBlobstoreS3(t *testing.T) {
+	if tools.GetEnv("SKIP_S3_TESTS", "false") == "true" {
+		t.Skip("Skipping S3 tests")
+	}
+
+	resourceName := "nexus_blobstore_s3.acceptance"
+	awsAccessKeyID := tools.GetEnv("AWS_ACCESS_KEY_ID", "")
+	awsSecretAccessKey := tools.GetEnv("AWS_SECRET_ACCESS_KEY", "")
+	bs := testAccResourceBlobstoreS3()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acceptance.AccPreCheck(t) },
@@ -54,6 +61,9 @@ func TestAccResourceBlobstoreS3(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.bucket_security.0.secret_access_key", awsSecretAccessKey),
 					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.advanced_bucket_connection.0.endpoint", bs.BucketConfiguration.AdvancedBucketConnection.Endpoint),
 					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.advanced_bucket_connection.0.force_path_style", strconv.FormatBool(*bs.BucketConfiguration.AdvancedBucketConnection.ForcePathStyle)),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.advanced_bucket_connection.0.signer_type", bs.BucketConfiguration.AdvancedBucketConnection.SignerType),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.advanced_bucket_connection.0.max_connection_pool_size", strconv.Itoa(int(*bs.BucketConfiguration.AdvancedBucketConnection.MaxConnectionPoolSize))),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.failover_buckets.#", "0"),
 				),
 			},
 			{
@@ -86,8 +96,73 @@ resource "nexus_blobstore_s3" "acceptance" {
 
 		advanced_bucket_connection {
  		  endpoint			= "%s"
+		  signer_type		= "%s"
 		  force_path_style	= %s
+		  max_connection_pool_size = %d
+		}
+	}
+}`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.Bucket.Expiration, awsAccessKeyID, awsSecretAccessKey, bs.BucketConfiguration.AdvancedBucketConnection.Endpoint, bs.BucketConfiguration.AdvancedBucketConnection.SignerType, strconv.FormatBool(*bs.BucketConfiguration.AdvancedBucketConnection.ForcePathStyle), *bs.BucketConfiguration.AdvancedBucketConnection.MaxConnectionPoolSize)
+}
+
+func TestAccResourceBlobstoreS3FailoverBuckets(t *testing.T) {
+	if tools.GetEnv("SKIP_S3_TESTS", "false") == "true" {
+		t.Skip("Skipping S3 tests")
+	}
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resourceName := "nexus_blobstore_s3.acceptance"
+	awsAccessKeyID := tools.GetEnv("AWS_ACCESS_KEY_ID", "")
+	awsSecretAccessKey := tools.GetEnv("AWS_SECRET_ACCESS_KEY", "")
+	bs := testAccResourceBlobstoreS3()
+	failoverRegion := tools.GetEnv("AWS_FAILOVER_REGION", "eu-west-1")
+	failoverBucketName := tools.GetEnv("AWS_FAILOVER_BUCKET_NAME", bs.BucketConfiguration.Bucket.Name+"-failover")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreTypeS3FailoverConfig(bs, awsAccessKeyID, awsSecretAccessKey, failoverRegion, failoverBucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", bs.Name),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.failover_buckets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.failover_buckets.0.region", failoverRegion),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.failover_buckets.0.bucket_name", failoverBucketName),
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.0.active_region", bs.BucketConfiguration.Bucket.Region),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceBlobstoreTypeS3FailoverConfig(bs blobstore.S3, awsAccessKeyID string, awsSecretAccessKey string, failoverRegion string, failoverBucketName string) string {
+	return fmt.Sprintf(`
+resource "nexus_blobstore_s3" "acceptance" {
+	name = "%s"
+
+	bucket_configuration {
+		bucket {
+			name       = "%s"
+			region     = "%s"
+			expiration = %d
+		}
+
+		bucket_security {
+			access_key_id     = "%s"
+			secret_access_key = "%s"
+		}
+
+		advanced_bucket_connection {
+			endpoint         = "%s"
+			force_path_style = true
+		}
+
+		failover_buckets {
+			region      = "%s"
+			bucket_name = "%s"
 		}
 	}
-}`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.Bucket.Expiration, awsAccessKeyID, awsSecretAccessKey, bs.BucketConfiguration.AdvancedBucketConnection.Endpoint, strconv.FormatBool(*bs.BucketConfiguration.AdvancedBucketConnection.ForcePathStyle))
+}`, bs.Name, bs.BucketConfiguration.Bucket.Name, bs.BucketConfiguration.Bucket.Region, bs.BucketConfiguration.Bucket.Expiration, awsAccessKeyID, awsSecretAccessKey, bs.BucketConfiguration.AdvancedBucketConnection.Endpoint, failoverRegion, failoverBucketName)
 }
@@ -0,0 +1,177 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+const (
+	blobstoreTaskCompact   = "COMPACT"
+	blobstoreTaskReconcile = "RECONCILE"
+
+	// blobstoreTaskLogLines is the number of lines of the task log kept in
+	// the state and shown in errors
+	blobstoreTaskLogLines = 20
+)
+
+func ResourceBlobstoreTask() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to run a maintenance task for a blobstore and wait until it finished, e.g. to compact a blobstore after a large cleanup.
+
+The task is run on creation and whenever the arguments or ` + "`triggers`" + ` change. Destroying the resource does not change anything in nexus.`,
+
+		CreateContext: resourceBlobstoreTaskCreate,
+		ReadContext:   resourceBlobstoreTaskRead,
+		DeleteContext: resourceBlobstoreTaskDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"blobstore": {
+				Description: "The name of the blobstore",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"task": {
+				Description: "The task to run. Possible values: `COMPACT` to delete the blobs which were soft deleted, `RECONCILE` to restore the component database from the blobstore",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					blobstoreTaskCompact,
+					blobstoreTaskReconcile,
+				}, false),
+			},
+			"dry_run": {
+				Default:     false,
+				Description: "Only log the changes of `RECONCILE` without restoring anything, defaults to `false` if unset",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"restore_blobs": {
+				Default:     true,
+				Description: "Let `RECONCILE` restore the metadata of components and assets which are missing, defaults to `true` if unset",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"undelete_blobs": {
+				Default:     true,
+				Description: "Let `RECONCILE` undelete blobs which were soft deleted but are still referenced by metadata, defaults to `true` if unset",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"integrity_check": {
+				Default:     true,
+				Description: "Let `RECONCILE` check the integrity of the blobs against their metadata, defaults to `true` if unset",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"triggers": {
+				Description: "Arbitrary values which cause the task to run again when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeMap,
+			},
+			"result": {
+				Description: "The result of the task, e.g. `OK`",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"message": {
+				Description: "The last message of the task",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"log": {
+				Description: "The last lines of the log of the task",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceBlobstoreTaskCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.FromMeta(m)
+
+	name := d.Get("blobstore").(string)
+	taskName := d.Get("task").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	progress := func(task *api.Task) {
+		log.Printf("[INFO] Task %s of blobstore %s: %s %s", taskName, name, task.CurrentState, task.Message)
+	}
+
+	var task *api.Task
+	var err error
+	switch taskName {
+	case blobstoreTaskReconcile:
+		task, err = client.Blobstore.Reconcile(ctx, name, api.BlobstoreReconcileOptions{
+			DryRun:         d.Get("dry_run").(bool),
+			RestoreBlobs:   d.Get("restore_blobs").(bool),
+			UndeleteBlobs:  d.Get("undelete_blobs").(bool),
+			IntegrityCheck: d.Get("integrity_check").(bool),
+		}, timeout, progress)
+	default:
+		task, err = client.Blobstore.Compact(ctx, name, timeout, progress)
+	}
+
+	// the log is only informational, so failing to read it is no error
+	taskLog := ""
+	if task != nil {
+		lines, logErr := client.Task.GetLog(task, blobstoreTaskLogLines)
+		if logErr != nil {
+			log.Printf("[WARN] Could not read the log of task %s of blobstore %s: %v", taskName, name, logErr)
+		}
+		taskLog = strings.Join(lines, "\n")
+	}
+
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Task %s of blobstore %s failed", taskName, name),
+			Detail:   getBlobstoreTaskErrorDetail(err, taskLog),
+		}}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", name, taskName))
+	d.Set("result", task.LastRunResult)
+	d.Set("message", task.Message)
+	d.Set("log", taskLog)
+	return nil
+}
+
+func getBlobstoreTaskErrorDetail(err error, taskLog string) string {
+	if taskLog == "" {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v\n\nLast lines of the task log:\n%s", err, taskLog)
+}
+
+func resourceBlobstoreTaskRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceBlobstoreTaskDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
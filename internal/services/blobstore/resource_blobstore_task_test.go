@@ -0,0 +1,55 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func testAccResourceBlobstoreTaskConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "nexus_blobstore_task" "acceptance" {
+	blobstore = nexus_blobstore_file.acceptance.name
+	task      = "COMPACT"
+
+	triggers = {
+		run = "%s"
+	}
+}
+`, trigger)
+}
+
+func TestAccResourceBlobstoreTaskCompact(t *testing.T) {
+	resourceName := "nexus_blobstore_task.acceptance"
+
+	bs := blobstore.File{
+		Name: fmt.Sprintf("test-blobstore-%s", acctest.RandString(5)),
+		Path: "/nexus-data/acceptance",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBlobstoreFileConfig(bs) + testAccResourceBlobstoreTaskConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", bs.Name+"/COMPACT"),
+					resource.TestCheckResourceAttr(resourceName, "result", "OK"),
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "false"),
+				),
+			},
+			{
+				Config: testAccResourceBlobstoreFileConfig(bs) + testAccResourceBlobstoreTaskConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+					resource.TestCheckResourceAttr(resourceName, "result", "OK"),
+				),
+			},
+		},
+	})
+}
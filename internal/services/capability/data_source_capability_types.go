@@ -0,0 +1,128 @@
+package capability
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func DataSourceCapabilityTypes() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to list all capability types and their property descriptors.
+
+The script of this data source is shared with the capability, webhook and repository firewall resources and stored in nexus by the first apply changing one of them, reading the data source fails before.
+
+~> This data source is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Read: dataSourceCapabilityTypesRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"types": {
+				Description: "List of capability types",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The id of the capability type",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"name": {
+							Description: "The name of the capability type",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"about": {
+							Description: "The description of the capability type",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"properties": {
+							Description: "The properties a capability of this type can be configured with",
+							Computed:    true,
+							Type:        schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The key of the property",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"type": {
+										Description: "The type of the property, e.g. `string`, `number` or `checkbox`",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"label": {
+										Description: "The name of the property",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"help_text": {
+										Description: "The description of the property",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"required": {
+										Description: "Whether the property must be set",
+										Computed:    true,
+										Type:        schema.TypeBool,
+									},
+									"regex_validation": {
+										Description: "A regular expression the value of the property must match",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+									"initial_value": {
+										Description: "The value used if the property is not set",
+										Computed:    true,
+										Type:        schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCapabilityTypesRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	types, err := client.Capability.ListTypes()
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId("capability-types")
+	return resourceData.Set("types", flattenCapabilityTypes(types))
+}
+
+func flattenCapabilityTypes(types []api.CapabilityType) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(types))
+	for i, capabilityType := range types {
+		properties := make([]map[string]interface{}, len(capabilityType.Properties))
+		for j, property := range capabilityType.Properties {
+			properties[j] = map[string]interface{}{
+				"id":               property.ID,
+				"type":             property.Type,
+				"label":            property.Label,
+				"help_text":        property.HelpText,
+				"required":         property.Required,
+				"regex_validation": property.RegexValidation,
+				"initial_value":    property.InitialValue,
+			}
+		}
+
+		data[i] = map[string]interface{}{
+			"id":         capabilityType.ID,
+			"name":       capabilityType.Name,
+			"about":      capabilityType.About,
+			"properties": properties,
+		}
+	}
+	return data
+}
@@ -0,0 +1,39 @@
+package capability_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+func TestAccDataSourceCapabilityTypes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the script is stored by changes of capabilities only
+				PreConfig: func() {
+					if err := api.FromMeta(acceptance.TestAccProvider.Meta()).Capability.StoreScript(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDataSourceCapabilityTypesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nexus_capability_types.acceptance", "types.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.nexus_capability_types.acceptance", "types.*", map[string]string{
+						"id": "baseurl",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCapabilityTypesConfig() string {
+	return `
+data "nexus_capability_types" "acceptance" {}
+`
+}
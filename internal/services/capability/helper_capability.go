@@ -0,0 +1,97 @@
+package capability
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// Nexus allows only one capability of the types below. Their resources use
+// the type id as resource id and adopt an existing capability on creation.
+const (
+	capabilityTypeAudit      = "audit"
+	capabilityTypeBaseURL    = "baseurl"
+	capabilityTypeUISettings = "rapture.settings"
+)
+
+// readSingletonCapability returns nil if the capability does not exist or
+// can not be read because the script is not stored yet, the state is kept in
+// the latter case
+func readSingletonCapability(resourceData *schema.ResourceData, m interface{}, typeID string) (*api.Capability, error) {
+	client := api.FromMeta(m)
+
+	capability, err := client.Capability.GetByType(typeID)
+	if errors.Is(err, api.ErrScriptNotStored) {
+		log.Printf("[WARN] Could not refresh capability %s: %v", typeID, err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if capability == nil {
+		resourceData.SetId("")
+		return nil, nil
+	}
+
+	resourceData.SetId(typeID)
+	resourceData.Set("enabled", capability.Enabled)
+	resourceData.Set("notes", capability.Notes)
+	return capability, nil
+}
+
+func saveSingletonCapability(resourceData *schema.ResourceData, m interface{}, typeID string, properties map[string]string) error {
+	client := api.FromMeta(m)
+
+	if err := client.Capability.StoreScript(); err != nil {
+		return err
+	}
+	existing, err := client.Capability.GetByType(typeID)
+	if err != nil {
+		return err
+	}
+
+	capability := api.Capability{
+		TypeID:     typeID,
+		Enabled:    resourceData.Get("enabled").(bool),
+		Notes:      resourceData.Get("notes").(string),
+		Properties: properties,
+	}
+
+	if existing == nil {
+		if _, err := client.Capability.Create(&capability); err != nil {
+			return err
+		}
+	} else {
+		capability.ID = existing.ID
+		if _, err := client.Capability.Update(&capability); err != nil {
+			return err
+		}
+	}
+
+	resourceData.SetId(typeID)
+	return nil
+}
+
+func deleteSingletonCapability(resourceData *schema.ResourceData, m interface{}, typeID string) error {
+	client := api.FromMeta(m)
+
+	if err := client.Capability.StoreScript(); err != nil {
+		return err
+	}
+	capability, err := client.Capability.GetByType(typeID)
+	if err != nil {
+		return err
+	}
+
+	if capability != nil {
+		if err := client.Capability.Delete(capability.ID); err != nil {
+			return err
+		}
+	}
+
+	resourceData.SetId("")
+	return nil
+}
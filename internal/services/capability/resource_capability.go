@@ -0,0 +1,187 @@
+package capability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	capabilitySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceCapability() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to create and configure a capability of the nexus repository manager.
+
+The properties are validated against the property descriptors of the capability type during plan, use the ` + "`nexus_capability_types`" + ` data source to list them. Only changes store the script of this resource in nexus, so until the first apply changing a capability, or after an upgrade of the provider changing the script, the validation is skipped and refreshes keep the state.
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create:        resourceCapabilityCreate,
+		Read:          resourceCapabilityRead,
+		Update:        resourceCapabilityUpdate,
+		Delete:        resourceCapabilityDelete,
+		CustomizeDiff: resourceCapabilityCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"type": {
+				Description: "The id of the capability type, e.g. `baseurl`",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"enabled": capabilitySchema.ResourceEnabled,
+			"notes":   capabilitySchema.ResourceNotes,
+			"properties": {
+				Description: "The properties of the capability. Properties which are not set use the default of the capability type",
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"active": {
+				Description: "Whether the capability is active",
+				Computed:    true,
+				Type:        schema.TypeBool,
+			},
+			"error": {
+				Description: "The failure preventing the capability from being active",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func getCapabilityFromResourceData(resourceData *schema.ResourceData) api.Capability {
+	return api.Capability{
+		ID:         resourceData.Id(),
+		TypeID:     resourceData.Get("type").(string),
+		Enabled:    resourceData.Get("enabled").(bool),
+		Notes:      resourceData.Get("notes").(string),
+		Properties: getCapabilityProperties(resourceData.Get("properties").(map[string]interface{})),
+	}
+}
+
+func getCapabilityProperties(data map[string]interface{}) map[string]string {
+	properties := make(map[string]string, len(data))
+	for key, value := range data {
+		properties[key] = value.(string)
+	}
+	return properties
+}
+
+func setCapabilityToResourceData(capability *api.Capability, resourceData *schema.ResourceData) error {
+	resourceData.SetId(capability.ID)
+	resourceData.Set("type", capability.TypeID)
+	resourceData.Set("enabled", capability.Enabled)
+	resourceData.Set("notes", capability.Notes)
+	resourceData.Set("active", capability.Active)
+	resourceData.Set("error", capability.Error)
+
+	// nexus returns the defaults of all properties which are not set, so only
+	// the managed properties are read back unless the resource is imported
+	properties := capability.Properties
+	if configured := resourceData.Get("properties").(map[string]interface{}); len(configured) > 0 {
+		properties = make(map[string]string, len(configured))
+		for key := range configured {
+			if value, ok := capability.Properties[key]; ok {
+				properties[key] = value
+			}
+		}
+	}
+	if err := resourceData.Set("properties", properties); err != nil {
+		return fmt.Errorf("error reading properties: %s", err)
+	}
+	return nil
+}
+
+func resourceCapabilityCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	capability := getCapabilityFromResourceData(resourceData)
+	created, err := client.Capability.Create(&capability)
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(created.ID)
+	return resourceCapabilityRead(resourceData, m)
+}
+
+func resourceCapabilityRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	capability, err := client.Capability.Get(resourceData.Id())
+	if errors.Is(err, api.ErrScriptNotStored) {
+		log.Printf("[WARN] Could not refresh capability %s: %v", resourceData.Id(), err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if capability == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	return setCapabilityToResourceData(capability, resourceData)
+}
+
+func resourceCapabilityUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	capability := getCapabilityFromResourceData(resourceData)
+	if _, err := client.Capability.Update(&capability); err != nil {
+		return err
+	}
+
+	return resourceCapabilityRead(resourceData, m)
+}
+
+func resourceCapabilityDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.Capability.Delete(resourceData.Id()); err != nil {
+		return err
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+// resourceCapabilityCustomizeDiff validates the properties against the
+// capability type if the script listing the types is stored already, a plan
+// must not change nexus
+func resourceCapabilityCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("properties") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("type") && !diff.HasChange("properties") {
+		return nil
+	}
+
+	client := api.FromMeta(m)
+
+	typeID := diff.Get("type").(string)
+	capabilityType, err := client.Capability.GetType(typeID)
+	if err != nil {
+		// the apply reports the errors of nexus anyway
+		log.Printf("[WARN] Skipping validation of the properties of capability type %s: %v", typeID, err)
+		return nil
+	}
+	if capabilityType == nil {
+		return fmt.Errorf("capability type %s does not exist", typeID)
+	}
+
+	return capabilityType.Validate(getCapabilityProperties(diff.Get("properties").(map[string]interface{})))
+}
@@ -0,0 +1,48 @@
+package capability
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	capabilitySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceCapabilityAudit() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to enable or disable the audit log of the nexus repository manager, which records changes to the configuration and security of nexus.
+
+!> This resource can only be used **once** for a nexus
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create: resourceCapabilityAuditUpdate,
+		Read:   resourceCapabilityAuditRead,
+		Update: resourceCapabilityAuditUpdate,
+		Delete: resourceCapabilityAuditDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id":      common.ResourceID,
+			"enabled": capabilitySchema.ResourceEnabled,
+			"notes":   capabilitySchema.ResourceNotes,
+		},
+	}
+}
+
+func resourceCapabilityAuditRead(resourceData *schema.ResourceData, m interface{}) error {
+	_, err := readSingletonCapability(resourceData, m, capabilityTypeAudit)
+	return err
+}
+
+func resourceCapabilityAuditUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	if err := saveSingletonCapability(resourceData, m, capabilityTypeAudit, map[string]string{}); err != nil {
+		return err
+	}
+
+	return resourceCapabilityAuditRead(resourceData, m)
+}
+
+func resourceCapabilityAuditDelete(resourceData *schema.ResourceData, m interface{}) error {
+	return deleteSingletonCapability(resourceData, m, capabilityTypeAudit)
+}
@@ -0,0 +1,48 @@
+package capability_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceCapabilityAudit(t *testing.T) {
+	resName := "nexus_capability_audit.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCapabilityAuditConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "audit"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceCapabilityAuditConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "audit"),
+					resource.TestCheckResourceAttr(resName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     "audit",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCapabilityAuditConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "nexus_capability_audit" "acceptance" {
+	enabled = %s
+}`, strconv.FormatBool(enabled))
+}
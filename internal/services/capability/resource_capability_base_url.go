@@ -0,0 +1,63 @@
+package capability
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	capabilitySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceCapabilityBaseURL() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to set the base URL of the nexus repository manager, which is used e.g. for links in emails and API responses.
+
+!> This resource can only be used **once** for a nexus
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create: resourceCapabilityBaseURLUpdate,
+		Read:   resourceCapabilityBaseURLRead,
+		Update: resourceCapabilityBaseURLUpdate,
+		Delete: resourceCapabilityBaseURLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id":      common.ResourceID,
+			"enabled": capabilitySchema.ResourceEnabled,
+			"notes":   capabilitySchema.ResourceNotes,
+			"url": {
+				Description:  "The base URL of the nexus repository manager",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+	}
+}
+
+func resourceCapabilityBaseURLRead(resourceData *schema.ResourceData, m interface{}) error {
+	capability, err := readSingletonCapability(resourceData, m, capabilityTypeBaseURL)
+	if err != nil || capability == nil {
+		return err
+	}
+
+	resourceData.Set("url", capability.Properties["url"])
+	return nil
+}
+
+func resourceCapabilityBaseURLUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	properties := map[string]string{
+		"url": resourceData.Get("url").(string),
+	}
+	if err := saveSingletonCapability(resourceData, m, capabilityTypeBaseURL, properties); err != nil {
+		return err
+	}
+
+	return resourceCapabilityBaseURLRead(resourceData, m)
+}
+
+func resourceCapabilityBaseURLDelete(resourceData *schema.ResourceData, m interface{}) error {
+	return deleteSingletonCapability(resourceData, m, capabilityTypeBaseURL)
+}
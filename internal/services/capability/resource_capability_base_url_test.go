@@ -0,0 +1,44 @@
+package capability_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceCapabilityBaseURL(t *testing.T) {
+	resName := "nexus_capability_base_url.acceptance"
+	url := "https://nexus.example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCapabilityBaseURLConfig(url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "baseurl"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "notes", "acceptance"),
+					resource.TestCheckResourceAttr(resName, "url", url),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     "baseurl",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCapabilityBaseURLConfig(url string) string {
+	return fmt.Sprintf(`
+resource "nexus_capability_base_url" "acceptance" {
+	url   = "%s"
+	notes = "acceptance"
+}`, url)
+}
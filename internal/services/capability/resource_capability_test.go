@@ -0,0 +1,61 @@
+package capability_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceCapability(t *testing.T) {
+	resName := "nexus_capability.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCapabilityConfig("headerEnabled", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "type", "rapture.branding"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "notes", "acceptance"),
+					resource.TestCheckResourceAttr(resName, "properties.%", "2"),
+					resource.TestCheckResourceAttr(resName, "properties.headerEnabled", "true"),
+					resource.TestCheckResourceAttr(resName, "properties.headerHtml", "<b>acceptance</b>"),
+					resource.TestCheckResourceAttr(resName, "active", "true"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"properties"},
+			},
+			{
+				Config:      testAccResourceCapabilityConfig("headerEnabled", "sometimes"),
+				ExpectError: regexp.MustCompile(`property "headerEnabled" of capability type rapture.branding must be true or false`),
+			},
+			{
+				Config:      testAccResourceCapabilityConfig("unknown", "true"),
+				ExpectError: regexp.MustCompile(`property "unknown" is not supported by capability type rapture.branding`),
+			},
+		},
+	})
+}
+
+func testAccResourceCapabilityConfig(key string, value string) string {
+	return fmt.Sprintf(`
+resource "nexus_capability" "acceptance" {
+	type  = "rapture.branding"
+	notes = "acceptance"
+
+	properties = {
+		%s = "%s"
+		headerHtml = "<b>acceptance</b>"
+	}
+}`, key, value)
+}
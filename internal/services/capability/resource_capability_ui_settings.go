@@ -0,0 +1,157 @@
+package capability
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	capabilitySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+// uiSettingsIntProperties maps the integer attributes of the resource to the
+// capability properties of the same meaning
+var uiSettingsIntProperties = map[string]string{
+	"session_timeout":               "sessionTimeout",
+	"request_timeout":               "requestTimeout",
+	"long_request_timeout":          "longRequestTimeout",
+	"search_request_timeout":        "searchRequestTimeout",
+	"status_interval_authenticated": "statusIntervalAuthenticated",
+	"status_interval_anonymous":     "statusIntervalAnonymous",
+}
+
+func ResourceCapabilityUISettings() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to configure the user interface settings of the nexus repository manager, e.g. the session timeout.
+
+Attributes which are not set keep the value configured in nexus.
+
+!> This resource can only be used **once** for a nexus
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create: resourceCapabilityUISettingsUpdate,
+		Read:   resourceCapabilityUISettingsRead,
+		Update: resourceCapabilityUISettingsUpdate,
+		Delete: resourceCapabilityUISettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id":      common.ResourceID,
+			"enabled": capabilitySchema.ResourceEnabled,
+			"notes":   capabilitySchema.ResourceNotes,
+			"debug_allowed": {
+				Description: "Whether the debug mode of the user interface can be enabled, defaults to `true` if unset",
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"title": {
+				Description: "The title of the browser window",
+				Optional:    true,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"session_timeout": {
+				Description:  "Minutes of inactivity before a user is signed out of the user interface",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"request_timeout": {
+				Description:  "Seconds to wait for a request of the user interface to complete",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"long_request_timeout": {
+				Description:  "Minutes to wait for a long running request of the user interface to complete",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"search_request_timeout": {
+				Description:  "Seconds to wait for a search request of the user interface to complete",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"status_interval_authenticated": {
+				Description:  "Seconds between status requests of the user interface for signed in users",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"status_interval_anonymous": {
+				Description:  "Seconds between status requests of the user interface for anonymous users",
+				Optional:     true,
+				Computed:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func getCapabilityUISettingsPropertiesFromResourceData(resourceData *schema.ResourceData) map[string]string {
+	properties := map[string]string{
+		"debugAllowed": strconv.FormatBool(resourceData.Get("debug_allowed").(bool)),
+	}
+	if title, ok := resourceData.GetOk("title"); ok {
+		properties["title"] = title.(string)
+	}
+	for attribute, property := range uiSettingsIntProperties {
+		if value, ok := resourceData.GetOk(attribute); ok {
+			properties[property] = strconv.Itoa(value.(int))
+		}
+	}
+	return properties
+}
+
+func resourceCapabilityUISettingsRead(resourceData *schema.ResourceData, m interface{}) error {
+	capability, err := readSingletonCapability(resourceData, m, capabilityTypeUISettings)
+	if err != nil || capability == nil {
+		return err
+	}
+
+	if value, ok := capability.Properties["debugAllowed"]; ok {
+		debugAllowed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		resourceData.Set("debug_allowed", debugAllowed)
+	}
+	resourceData.Set("title", capability.Properties["title"])
+	for attribute, property := range uiSettingsIntProperties {
+		value, ok := capability.Properties[property]
+		if !ok || value == "" {
+			continue
+		}
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		resourceData.Set(attribute, intValue)
+	}
+	return nil
+}
+
+func resourceCapabilityUISettingsUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	properties := getCapabilityUISettingsPropertiesFromResourceData(resourceData)
+	if err := saveSingletonCapability(resourceData, m, capabilityTypeUISettings, properties); err != nil {
+		return err
+	}
+
+	return resourceCapabilityUISettingsRead(resourceData, m)
+}
+
+func resourceCapabilityUISettingsDelete(resourceData *schema.ResourceData, m interface{}) error {
+	return deleteSingletonCapability(resourceData, m, capabilityTypeUISettings)
+}
@@ -0,0 +1,49 @@
+package capability_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceCapabilityUISettings(t *testing.T) {
+	resName := "nexus_capability_ui_settings.acceptance"
+	title := "Nexus Acceptance"
+	sessionTimeout := 45
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCapabilityUISettingsConfig(title, sessionTimeout),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "rapture.settings"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "debug_allowed", "true"),
+					resource.TestCheckResourceAttr(resName, "title", title),
+					resource.TestCheckResourceAttr(resName, "session_timeout", strconv.Itoa(sessionTimeout)),
+					resource.TestCheckResourceAttrSet(resName, "request_timeout"),
+					resource.TestCheckResourceAttrSet(resName, "status_interval_anonymous"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     "rapture.settings",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCapabilityUISettingsConfig(title string, sessionTimeout int) string {
+	return fmt.Sprintf(`
+resource "nexus_capability_ui_settings" "acceptance" {
+	title           = "%s"
+	session_timeout = %d
+}`, title, sessionTimeout)
+}
@@ -0,0 +1,91 @@
+package component
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+var dataSourceAssetSchema = map[string]*schema.Schema{
+	"id": {
+		Description: "The id of the asset",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"path": {
+		Description: "The path of the asset in the repository",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"download_url": {
+		Description: "The URL the asset can be downloaded from",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"repository": {
+		Description: "The name of the repository of the asset",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"format": {
+		Description: "The format of the asset",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"content_type": {
+		Description: "The content type of the asset",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"last_modified": {
+		Description: "The date the asset was last modified",
+		Computed:    true,
+		Type:        schema.TypeString,
+	},
+	"file_size": {
+		Description: "The size of the asset in bytes",
+		Computed:    true,
+		Type:        schema.TypeInt,
+	},
+	"checksum": {
+		Description: "The checksums of the asset by algorithm, e.g. `sha1` or `sha256`",
+		Computed:    true,
+		Type:        schema.TypeMap,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	},
+}
+
+func DataSourceAssets() *schema.Resource {
+	dataSourceSchema := searchSchema()
+	dataSourceSchema["id"] = common.DataSourceID
+	dataSourceSchema["assets"] = &schema.Schema{
+		Description: "The assets matching the filters",
+		Computed:    true,
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: dataSourceAssetSchema,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to search for assets, e.g. to look up the checksum or download URL of a published artifact.",
+
+		Read:   dataSourceAssetsRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceAssetsRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	query := getSearchQuery(d)
+	assets, err := client.Component.SearchAssetsAll(query)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(getSearchID(query))
+	return d.Set("assets", flattenAssets(assets))
+}
@@ -0,0 +1,45 @@
+package component_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccDataSourceAssets(t *testing.T) {
+	dataSourceName := "data.nexus_assets.acceptance"
+	repoName := fmt.Sprintf("acceptance-assets-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "hello.sh")
+	writeComponentSource(t, source, "echo hello")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceComponentRawConfig(repoName, source) + testAccDataSourceAssetsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "assets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "assets.0.path", "scripts/hello.sh"),
+					resource.TestCheckResourceAttr(dataSourceName, "assets.0.repository", repoName),
+					resource.TestCheckResourceAttr(dataSourceName, "assets.0.checksum.sha256", sha256Hex("echo hello")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "assets.0.download_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAssetsConfig() string {
+	return `
+data "nexus_assets" "acceptance" {
+	repository = nexus_component.acceptance.repository
+	name       = nexus_component.acceptance.name
+}
+`
+}
@@ -0,0 +1,79 @@
+package component
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func DataSourceComponents() *schema.Resource {
+	dataSourceSchema := searchSchema()
+	dataSourceSchema["id"] = common.DataSourceID
+	dataSourceSchema["components"] = &schema.Schema{
+		Description: "The components matching the filters",
+		Computed:    true,
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The id of the component",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"repository": {
+					Description: "The name of the repository of the component",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"format": {
+					Description: "The format of the component",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"group": {
+					Description: "The group of the component",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"name": {
+					Description: "The name of the component",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"version": {
+					Description: "The version of the component",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"assets": {
+					Description: "The assets of the component",
+					Computed:    true,
+					Type:        schema.TypeList,
+					Elem: &schema.Resource{
+						Schema: dataSourceAssetSchema,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to search for components.",
+
+		Read:   dataSourceComponentsRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceComponentsRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	query := getSearchQuery(d)
+	components, err := client.Component.SearchAll(query)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(getSearchID(query))
+	return d.Set("components", flattenComponents(components))
+}
@@ -0,0 +1,54 @@
+package component_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccDataSourceComponents(t *testing.T) {
+	dataSourceName := "data.nexus_components.acceptance"
+	repoName := fmt.Sprintf("acceptance-components-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "library.jar")
+	writeComponentSource(t, source, "not really a jar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceComponentMavenConfig(repoName, source) + testAccDataSourceComponentsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "components.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "components.0.repository", repoName),
+					resource.TestCheckResourceAttr(dataSourceName, "components.0.group", "com.example"),
+					resource.TestCheckResourceAttr(dataSourceName, "components.0.name", "library"),
+					resource.TestCheckResourceAttr(dataSourceName, "components.0.version", "1.0.0"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "components.0.assets.*", map[string]string{
+						"path":            "com/example/library/1.0.0/library-1.0.0.jar",
+						"checksum.sha256": sha256Hex("not really a jar"),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComponentsConfig() string {
+	return `
+data "nexus_components" "acceptance" {
+	repository = nexus_component.acceptance.repository
+	group      = nexus_component.acceptance.group
+	name       = nexus_component.acceptance.name
+
+	format_filters = {
+		"maven.extension" = "jar"
+	}
+}
+`
+}
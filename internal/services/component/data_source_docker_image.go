@@ -0,0 +1,151 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func DataSourceDockerImage() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to resolve the digest of a docker image tag with the Docker Registry v2 API of a docker repository, e.g. to pin images by digest.
+
+The registry is accessed with the credentials of the provider. If the repository requests a bearer token, it is obtained from the token realm of nexus, which requires the Docker Bearer Token Realm to be active.`,
+
+		Read: dataSourceDockerImageRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"repository": {
+				Description: "The name of the docker repository",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"image": {
+				Description: "The name of the image, e.g. `library/alpine`",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"tag": {
+				Default:     "latest",
+				Description: "The tag of the image, defaults to `latest` if unset",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"endpoint": {
+				Default:     dockerEndpointPath,
+				Description: "How the registry of the repository is accessed, possible values: `PATH` for the path based endpoint `<nexus url>/repository/<name>/v2`, `HTTP` or `HTTPS` for the connector port of the repository. Defaults to `PATH` if unset",
+				Optional:    true,
+				Type:        schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					dockerEndpointPath,
+					dockerEndpointHTTP,
+					dockerEndpointHTTPS,
+				}, false),
+			},
+			"registry_url": {
+				Description: "The base URL of the registry the image was read from",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"digest": {
+				Description: "The content digest of the manifest of the tag",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"media_type": {
+				Description: "The media type of the manifest of the tag",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"platforms": {
+				Description: "The platforms of the image, only set if the tag refers to a manifest list or OCI image index",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os": {
+							Description: "The operating system of the platform",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"architecture": {
+							Description: "The CPU architecture of the platform",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"variant": {
+							Description: "The variant of the CPU architecture, e.g. `v7` for arm",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"digest": {
+							Description: "The digest of the manifest of the platform",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"media_type": {
+							Description: "The media type of the manifest of the platform",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			"tags": {
+				Description: "All tags of the image",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDockerImageRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	repositoryName := d.Get("repository").(string)
+	image := d.Get("image").(string)
+	tag := d.Get("tag").(string)
+
+	docker, err := getDockerRepository(m, repositoryName)
+	if err != nil {
+		return err
+	}
+
+	registryURL, err := getDockerRegistryURL(client, repositoryName, docker, d.Get("endpoint").(string))
+	if err != nil {
+		return err
+	}
+
+	manifest, err := client.Docker.GetManifest(registryURL, image, tag)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return fmt.Errorf("image %s:%s does not exist in repository %s", image, tag, repositoryName)
+	}
+
+	tags, err := client.Docker.ListTags(registryURL, image)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s:%s", repositoryName, image, tag))
+	d.Set("registry_url", registryURL)
+	d.Set("digest", manifest.Digest)
+	d.Set("media_type", manifest.MediaType)
+	if err := d.Set("platforms", flattenDockerPlatforms(manifest)); err != nil {
+		return fmt.Errorf("error reading platforms: %s", err)
+	}
+	if err := d.Set("tags", tools.StringSliceToInterfaceSlice(tags)); err != nil {
+		return fmt.Errorf("error reading tags: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package component_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccDataSourceDockerImage(t *testing.T) {
+	dataSourceName := "data.nexus_docker_image.acceptance"
+	repoName := fmt.Sprintf("acceptance-docker-image-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDockerImageConfig(repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint", "PATH"),
+					resource.TestMatchResourceAttr(dataSourceName, "registry_url", regexp.MustCompile(fmt.Sprintf("/repository/%s$", repoName))),
+					resource.TestMatchResourceAttr(dataSourceName, "digest", regexp.MustCompile("^sha256:[0-9a-f]{64}$")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "media_type"),
+					resource.TestMatchResourceAttr(dataSourceName, "platforms.#", regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "tags.*", "latest"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDockerImageConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_docker_proxy" "acceptance" {
+	name = "%s"
+
+	docker {
+		force_basic_auth = false
+		v1_enabled       = false
+	}
+
+	docker_proxy {
+		index_type = "HUB"
+	}
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+
+	proxy {
+		remote_url       = "https://registry-1.docker.io"
+		content_max_age  = 1440
+		metadata_max_age = 1440
+	}
+
+	http_client {
+		blocked    = false
+		auto_block = true
+	}
+}
+
+data "nexus_docker_image" "acceptance" {
+	repository = nexus_repository_docker_proxy.acceptance.name
+	image      = "library/hello-world"
+	tag        = "latest"
+}
+`, repoName)
+}
@@ -0,0 +1,155 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func DataSourceMavenLatestVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to look up the latest version of a maven artifact from the `maven-metadata.xml` of a maven repository.",
+
+		Read: dataSourceMavenLatestVersionRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"repository": {
+				Description: "The name of the maven repository",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"group_id": {
+				Description: "The group id of the artifact",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"artifact_id": {
+				Description: "The artifact id of the artifact",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"version_policy": {
+				Description: "Which versions are considered, possible values: `RELEASE`, `SNAPSHOT` or `MIXED`. Defaults to the version policy of the repository, or `RELEASE` for group repositories",
+				Optional:    true,
+				Computed:    true,
+				Type:        schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(repository.MavenVersionPolicyRelease),
+					string(repository.MavenVersionPolicySnapshot),
+					string(repository.MavenVersionPolicyMixed),
+				}, false),
+			},
+			"extension": {
+				Default:     "jar",
+				Description: "The extension of the file the download URL points to, defaults to `jar` if unset",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"classifier": {
+				Description: "The classifier of the file the download URL points to",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"version": {
+				Description: "The latest version of the artifact matching the version policy",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"latest": {
+				Description: "The latest version of the artifact, including snapshots",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"release": {
+				Description: "The latest release version of the artifact",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"versions": {
+				Description: "All versions of the artifact",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"file_version": {
+				Description: "The version used in the file name, i.e. the timestamped version for snapshots",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"download_url": {
+				Description: "The URL of the file with the configured extension and classifier",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceMavenLatestVersionRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	repositoryName := d.Get("repository").(string)
+	groupID := d.Get("group_id").(string)
+	artifactID := d.Get("artifact_id").(string)
+	extension := d.Get("extension").(string)
+	classifier := d.Get("classifier").(string)
+
+	policy := d.Get("version_policy").(string)
+	if policy == "" {
+		var err error
+		if policy, err = getMavenRepositoryVersionPolicy(m, repositoryName); err != nil {
+			return err
+		}
+	}
+
+	metadata, err := client.Maven.GetMetadata(repositoryName, groupID, artifactID, "")
+	if err != nil {
+		return err
+	}
+	if metadata == nil {
+		return fmt.Errorf("artifact %s:%s does not exist in repository %s", groupID, artifactID, repositoryName)
+	}
+
+	version := selectMavenVersion(metadata, policy)
+	if version == "" {
+		return fmt.Errorf("artifact %s:%s has no version matching version policy %s in repository %s", groupID, artifactID, policy, repositoryName)
+	}
+
+	fileVersion := version
+	if strings.HasSuffix(version, "-SNAPSHOT") {
+		snapshotMetadata, err := client.Maven.GetMetadata(repositoryName, groupID, artifactID, version)
+		if err != nil {
+			return err
+		}
+		if snapshotMetadata != nil {
+			fileVersion = getMavenSnapshotFileVersion(snapshotMetadata, version, classifier, extension)
+		}
+	}
+
+	fileName := fmt.Sprintf("%s-%s", artifactID, fileVersion)
+	if classifier != "" {
+		fileName += "-" + classifier
+	}
+	fileName += "." + extension
+	path := fmt.Sprintf("%s/%s/%s/%s", strings.ReplaceAll(groupID, ".", "/"), artifactID, version, fileName)
+
+	d.SetId(fmt.Sprintf("%s/%s:%s", repositoryName, groupID, artifactID))
+	d.Set("version_policy", policy)
+	d.Set("version", version)
+	d.Set("latest", metadata.Versioning.Latest)
+	d.Set("release", metadata.Versioning.Release)
+	d.Set("file_version", fileVersion)
+	d.Set("download_url", client.Maven.ContentURL(repositoryName, path))
+	if err := d.Set("versions", tools.StringSliceToInterfaceSlice(metadata.Versioning.Versions)); err != nil {
+		return fmt.Errorf("error reading versions: %s", err)
+	}
+	return nil
+}
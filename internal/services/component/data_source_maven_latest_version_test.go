@@ -0,0 +1,83 @@
+package component_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccDataSourceMavenLatestVersion(t *testing.T) {
+	dataSourceName := "data.nexus_maven_latest_version.acceptance"
+	repoName := fmt.Sprintf("acceptance-maven-version-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "library.jar")
+	writeComponentSource(t, source, "not really a jar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMavenLatestVersionConfig(repoName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "version_policy", "RELEASE"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "1.1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "release", "1.1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "file_version", "1.1.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "download_url", regexp.MustCompile(fmt.Sprintf("/repository/%s/com/example/library/1.1.0/library-1.1.0.jar$", repoName))),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMavenLatestVersionConfig(repoName string, source string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_maven_hosted" "acceptance" {
+	name = "%s"
+
+	maven {
+		version_policy = "RELEASE"
+		layout_policy  = "STRICT"
+	}
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_component" "acceptance" {
+	for_each = toset(["1.0.0", "1.1.0"])
+
+	repository = nexus_repository_maven_hosted.acceptance.name
+	format     = "maven2"
+
+	maven2 {
+		group_id     = "com.example"
+		artifact_id  = "library"
+		version      = each.key
+		generate_pom = true
+	}
+
+	asset {
+		source    = "%s"
+		extension = "jar"
+	}
+}
+
+data "nexus_maven_latest_version" "acceptance" {
+	depends_on = [nexus_component.acceptance]
+
+	repository  = nexus_repository_maven_hosted.acceptance.name
+	group_id    = "com.example"
+	artifact_id = "library"
+}
+`, repoName, source)
+}
@@ -0,0 +1,142 @@
+package component
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+const rawFileDefaultMaxSize = 1024 * 1024
+
+func DataSourceRawFile() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this data source to read a file from a raw repository, e.g. a CA bundle or a JSON configuration.
+
+The content of the file is stored in the terraform state, so the size of files is limited by ` + "`max_size`.",
+
+		ReadContext: dataSourceRawFileRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"repository": {
+				Description: "The name of the raw repository",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"path": {
+				Description: "The path of the file in the repository, e.g. `certs/ca.pem`",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"max_size": {
+				Default:      rawFileDefaultMaxSize,
+				Description:  "The maximum size of the file in bytes, larger files cause an error. Defaults to `1048576` (1 MiB) if unset",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"content": {
+				Description: "The content of the file, empty if the file is not valid UTF-8",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"content_base64": {
+				Description: "The base64 encoded content of the file",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"content_type": {
+				Description: "The content type of the file",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"size": {
+				Description: "The size of the file in bytes",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"last_modified": {
+				Description: "When the file was last modified, in RFC 3339 format",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"md5": {
+				Description: "The MD5 checksum of the file",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"sha1": {
+				Description: "The SHA-1 checksum of the file",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"sha256": {
+				Description: "The SHA-256 checksum of the file",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"sha512": {
+				Description: "The SHA-512 checksum of the file",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceRawFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.FromMeta(m)
+
+	repositoryName := d.Get("repository").(string)
+	path := d.Get("path").(string)
+
+	file, err := client.Raw.Download(ctx, repositoryName, path, int64(d.Get("max_size").(int)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if file == nil {
+		return diag.Errorf("file %s does not exist in repository %s", path, repositoryName)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", repositoryName, path))
+	setRawFileToResourceData(file, d)
+	return nil
+}
+
+func setRawFileToResourceData(file *api.RawFile, d *schema.ResourceData) {
+	content := ""
+	if utf8.Valid(file.Content) {
+		content = string(file.Content)
+	}
+	lastModified := ""
+	if !file.LastModified.IsZero() {
+		lastModified = file.LastModified.UTC().Format(time.RFC3339)
+	}
+
+	md5Sum := md5.Sum(file.Content)
+	sha1Sum := sha1.Sum(file.Content)
+	sha256Sum := sha256.Sum256(file.Content)
+	sha512Sum := sha512.Sum512(file.Content)
+
+	d.Set("content", content)
+	d.Set("content_base64", base64.StdEncoding.EncodeToString(file.Content))
+	d.Set("content_type", file.ContentType)
+	d.Set("size", len(file.Content))
+	d.Set("last_modified", lastModified)
+	d.Set("md5", hex.EncodeToString(md5Sum[:]))
+	d.Set("sha1", hex.EncodeToString(sha1Sum[:]))
+	d.Set("sha256", hex.EncodeToString(sha256Sum[:]))
+	d.Set("sha512", hex.EncodeToString(sha512Sum[:]))
+}
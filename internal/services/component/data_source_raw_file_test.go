@@ -0,0 +1,83 @@
+package component_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccDataSourceRawFile(t *testing.T) {
+	dataSourceName := "data.nexus_raw_file.acceptance"
+	repoName := fmt.Sprintf("acceptance-raw-file-%s", acctest.RandString(10))
+	content := `{"feature":true}`
+	source := filepath.Join(t.TempDir(), "flags.json")
+	writeComponentSource(t, source, content)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRawFileConfig(repoName, source, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", repoName+"/config/flags.json"),
+					resource.TestCheckResourceAttr(dataSourceName, "content", content),
+					resource.TestCheckResourceAttr(dataSourceName, "content_base64", base64.StdEncoding.EncodeToString([]byte(content))),
+					resource.TestCheckResourceAttr(dataSourceName, "size", strconv.Itoa(len(content))),
+					resource.TestCheckResourceAttr(dataSourceName, "sha256", sha256Hex(content)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "md5"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sha1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sha512"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_modified"),
+				),
+			},
+			{
+				Config:      testAccDataSourceRawFileConfig(repoName, source, 4),
+				ExpectError: regexp.MustCompile("is larger than 4 bytes"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRawFileConfig(repoName string, source string, maxSize int) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_component" "acceptance" {
+	repository = nexus_repository_raw_hosted.acceptance.name
+	format     = "raw"
+
+	raw {
+		directory = "config"
+	}
+
+	asset {
+		source   = "%s"
+		filename = "flags.json"
+	}
+}
+
+data "nexus_raw_file" "acceptance" {
+	depends_on = [nexus_component.acceptance]
+
+	repository = nexus_repository_raw_hosted.acceptance.name
+	path       = "config/flags.json"
+	max_size   = %d
+}
+`, repoName, source, maxSize)
+}
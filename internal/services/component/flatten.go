@@ -0,0 +1,39 @@
+package component
+
+import (
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+func flattenAssets(assets []api.Asset) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(assets))
+	for i, asset := range assets {
+		data[i] = map[string]interface{}{
+			"id":            asset.ID,
+			"path":          asset.Path,
+			"download_url":  asset.DownloadURL,
+			"repository":    asset.Repository,
+			"format":        asset.Format,
+			"content_type":  asset.ContentType,
+			"last_modified": asset.LastModified,
+			"file_size":     int(asset.FileSize),
+			"checksum":      asset.Checksum,
+		}
+	}
+	return data
+}
+
+func flattenComponents(components []api.Component) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(components))
+	for i, component := range components {
+		data[i] = map[string]interface{}{
+			"id":         component.ID,
+			"repository": component.Repository,
+			"format":     component.Format,
+			"group":      component.Group,
+			"name":       component.Name,
+			"version":    component.Version,
+			"assets":     flattenAssets(component.Assets),
+		}
+	}
+	return data
+}
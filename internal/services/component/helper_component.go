@@ -0,0 +1,195 @@
+package component
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// getComponentUpload builds the upload form from the configuration and
+// checks that only the fields of the configured format are set. get is
+// either ResourceData.Get or ResourceDiff.Get.
+func getComponentUpload(get func(string) interface{}) (api.ComponentUpload, error) {
+	format := get("format").(string)
+	upload := api.ComponentUpload{
+		Repository: get("repository").(string),
+		Fields:     map[string]string{},
+		Files:      map[string]string{},
+	}
+
+	rawList := get("raw").([]interface{})
+	mavenList := get("maven2").([]interface{})
+	yumList := get("yum").([]interface{})
+	if len(rawList) > 0 && format != componentFormatRaw {
+		return upload, fmt.Errorf("raw is only allowed for format %s", componentFormatRaw)
+	}
+	if len(mavenList) > 0 && format != componentFormatMaven {
+		return upload, fmt.Errorf("maven2 is only allowed for format %s", componentFormatMaven)
+	}
+	if len(yumList) > 0 && format != componentFormatYum {
+		return upload, fmt.Errorf("yum is only allowed for format %s", componentFormatYum)
+	}
+
+	assets := get("asset").([]interface{})
+	if len(assets) > 1 && format != componentFormatRaw && format != componentFormatMaven {
+		return upload, fmt.Errorf("format %s supports exactly one asset, got %d", format, len(assets))
+	}
+
+	switch format {
+	case componentFormatRaw:
+		if len(rawList) == 0 || rawList[0] == nil {
+			return upload, fmt.Errorf("raw is required for format %s", format)
+		}
+		upload.Fields["raw.directory"] = rawList[0].(map[string]interface{})["directory"].(string)
+	case componentFormatMaven:
+		if len(mavenList) == 0 || mavenList[0] == nil {
+			return upload, fmt.Errorf("maven2 is required for format %s", format)
+		}
+		maven := mavenList[0].(map[string]interface{})
+		upload.Fields["maven2.groupId"] = maven["group_id"].(string)
+		upload.Fields["maven2.artifactId"] = maven["artifact_id"].(string)
+		upload.Fields["maven2.version"] = maven["version"].(string)
+		upload.Fields["maven2.generate-pom"] = strconv.FormatBool(maven["generate_pom"].(bool))
+		if packaging := maven["packaging"].(string); packaging != "" {
+			upload.Fields["maven2.packaging"] = packaging
+		}
+	case componentFormatYum:
+		if len(yumList) == 1 && yumList[0] != nil {
+			if directory := yumList[0].(map[string]interface{})["directory"].(string); directory != "" {
+				upload.Fields["yum.directory"] = directory
+			}
+		}
+	}
+
+	for i, assetData := range assets {
+		if assetData == nil {
+			return upload, fmt.Errorf("asset %d: source is required", i)
+		}
+		asset := assetData.(map[string]interface{})
+		filename := asset["filename"].(string)
+		extension := asset["extension"].(string)
+		classifier := asset["classifier"].(string)
+		pathID := asset["path_id"].(string)
+
+		if filename != "" && format != componentFormatRaw && format != componentFormatYum {
+			return upload, fmt.Errorf("asset %d: filename is only allowed for formats %s and %s", i, componentFormatRaw, componentFormatYum)
+		}
+		if (extension != "" || classifier != "") && format != componentFormatMaven {
+			return upload, fmt.Errorf("asset %d: extension and classifier are only allowed for format %s", i, componentFormatMaven)
+		}
+		if pathID != "" && format != componentFormatR {
+			return upload, fmt.Errorf("asset %d: path_id is only allowed for format %s", i, componentFormatR)
+		}
+
+		field := fmt.Sprintf("%s.asset", format)
+		if format == componentFormatRaw || format == componentFormatMaven {
+			field = fmt.Sprintf("%s.asset%d", format, i+1)
+		}
+		upload.Files[field] = asset["source"].(string)
+
+		switch format {
+		case componentFormatRaw:
+			if filename == "" {
+				return upload, fmt.Errorf("asset %d: filename is required for format %s", i, format)
+			}
+			upload.Fields[field+".filename"] = filename
+		case componentFormatYum:
+			if filename != "" {
+				upload.Fields[field+".filename"] = filename
+			}
+		case componentFormatMaven:
+			if extension == "" {
+				return upload, fmt.Errorf("asset %d: extension is required for format %s", i, format)
+			}
+			upload.Fields[field+".extension"] = extension
+			if classifier != "" {
+				upload.Fields[field+".classifier"] = classifier
+			}
+		case componentFormatR:
+			if pathID == "" {
+				return upload, fmt.Errorf("asset %d: path_id is required for format %s", i, format)
+			}
+			upload.Fields[field+".pathId"] = pathID
+		}
+	}
+
+	return upload, nil
+}
+
+func getAssetSHA256(assets []interface{}) ([]string, error) {
+	checksums := make([]string, len(assets))
+	for i, assetData := range assets {
+		source := assetData.(map[string]interface{})["source"].(string)
+		checksum, err := fileSHA256(source)
+		if err != nil {
+			return nil, fmt.Errorf("asset %d: %v", i, err)
+		}
+		checksums[i] = checksum
+	}
+	return checksums, nil
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getUploadedComponentQuery returns the search query for the uploaded
+// component. Besides the checksum of the first asset it contains the
+// coordinates known from the upload, as the same file can be part of
+// several components.
+func getUploadedComponentQuery(upload api.ComponentUpload, checksum string) url.Values {
+	query := url.Values{}
+	query.Set("repository", upload.Repository)
+	query.Set("sha256", checksum)
+
+	if groupID, ok := upload.Fields["maven2.groupId"]; ok {
+		query.Set("maven.groupId", groupID)
+		query.Set("maven.artifactId", upload.Fields["maven2.artifactId"])
+		query.Set("maven.baseVersion", upload.Fields["maven2.version"])
+	}
+	if directory, ok := upload.Fields["raw.directory"]; ok {
+		name := upload.Fields["raw.asset1.filename"]
+		if directory = strings.Trim(directory, "/"); directory != "" {
+			name = directory + "/" + name
+		}
+		query.Set("name", name)
+	}
+	return query
+}
+
+// findUploadedComponent waits until the component matching query is indexed
+// by the search of nexus
+func findUploadedComponent(client *api.Client, query url.Values) (*api.Component, error) {
+	deadline := time.Now().Add(componentSearchTimeout)
+	for {
+		components, _, err := client.Component.Search(query)
+		if err != nil {
+			return nil, err
+		}
+		if len(components) > 0 {
+			return &components[0], nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("uploaded component with asset checksum %s not found in repository %s", query.Get("sha256"), query.Get("repository"))
+		}
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,135 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func getFromMap(data map[string]interface{}) func(string) interface{} {
+	return func(key string) interface{} {
+		if value, ok := data[key]; ok {
+			return value
+		}
+		if key == "repository" {
+			return ""
+		}
+		return []interface{}{}
+	}
+}
+
+func componentAsset(source string, fields map[string]interface{}) map[string]interface{} {
+	asset := map[string]interface{}{
+		"source":     source,
+		"filename":   "",
+		"extension":  "",
+		"classifier": "",
+		"path_id":    "",
+	}
+	for key, value := range fields {
+		asset[key] = value
+	}
+	return asset
+}
+
+func TestGetComponentUploadMaven(t *testing.T) {
+	upload, err := getComponentUpload(getFromMap(map[string]interface{}{
+		"repository": "maven-releases",
+		"format":     "maven2",
+		"maven2": []interface{}{map[string]interface{}{
+			"group_id":     "com.example",
+			"artifact_id":  "parent",
+			"version":      "1.0.0",
+			"generate_pom": false,
+			"packaging":    "",
+		}},
+		"asset": []interface{}{
+			componentAsset("parent.pom", map[string]interface{}{"extension": "pom"}),
+			componentAsset("parent-sources.jar", map[string]interface{}{"extension": "jar", "classifier": "sources"}),
+		},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "maven-releases", upload.Repository)
+	assert.Equal(t, map[string]string{
+		"maven2.groupId":           "com.example",
+		"maven2.artifactId":        "parent",
+		"maven2.version":           "1.0.0",
+		"maven2.generate-pom":      "false",
+		"maven2.asset1.extension":  "pom",
+		"maven2.asset2.extension":  "jar",
+		"maven2.asset2.classifier": "sources",
+	}, upload.Fields)
+	assert.Equal(t, map[string]string{
+		"maven2.asset1": "parent.pom",
+		"maven2.asset2": "parent-sources.jar",
+	}, upload.Files)
+}
+
+func TestGetComponentUploadNpm(t *testing.T) {
+	upload, err := getComponentUpload(getFromMap(map[string]interface{}{
+		"repository": "npm-internal",
+		"format":     "npm",
+		"asset":      []interface{}{componentAsset("package.tgz", nil)},
+	}))
+	assert.NoError(t, err)
+	assert.Empty(t, upload.Fields)
+	assert.Equal(t, map[string]string{"npm.asset": "package.tgz"}, upload.Files)
+}
+
+func TestGetComponentUploadInvalid(t *testing.T) {
+	_, err := getComponentUpload(getFromMap(map[string]interface{}{
+		"format": "raw",
+		"asset":  []interface{}{componentAsset("hello.sh", nil)},
+	}))
+	assert.EqualError(t, err, "raw is required for format raw")
+
+	_, err = getComponentUpload(getFromMap(map[string]interface{}{
+		"format": "raw",
+		"raw":    []interface{}{map[string]interface{}{"directory": "scripts"}},
+		"asset":  []interface{}{componentAsset("hello.sh", nil)},
+	}))
+	assert.EqualError(t, err, "asset 0: filename is required for format raw")
+
+	_, err = getComponentUpload(getFromMap(map[string]interface{}{
+		"format": "npm",
+		"raw":    []interface{}{map[string]interface{}{"directory": "scripts"}},
+		"asset":  []interface{}{componentAsset("package.tgz", nil)},
+	}))
+	assert.EqualError(t, err, "raw is only allowed for format raw")
+
+	_, err = getComponentUpload(getFromMap(map[string]interface{}{
+		"format": "pypi",
+		"asset":  []interface{}{componentAsset("a.whl", nil), componentAsset("b.whl", nil)},
+	}))
+	assert.EqualError(t, err, "format pypi supports exactly one asset, got 2")
+
+	_, err = getComponentUpload(getFromMap(map[string]interface{}{
+		"format": "r",
+		"asset":  []interface{}{componentAsset("pkg.tar.gz", map[string]interface{}{"classifier": "sources"})},
+	}))
+	assert.EqualError(t, err, "asset 0: extension and classifier are only allowed for format maven2")
+}
+
+func TestGetUploadedComponentQuery(t *testing.T) {
+	query := getUploadedComponentQuery(api.ComponentUpload{
+		Repository: "raw-internal",
+		Fields: map[string]string{
+			"raw.directory":       "/scripts/",
+			"raw.asset1.filename": "hello.sh",
+		},
+	}, "abc")
+	assert.Equal(t, "name=scripts%2Fhello.sh&repository=raw-internal&sha256=abc", query.Encode())
+
+	query = getUploadedComponentQuery(api.ComponentUpload{
+		Repository: "maven-releases",
+		Fields: map[string]string{
+			"maven2.groupId":    "com.example",
+			"maven2.artifactId": "library",
+			"maven2.version":    "1.1.0",
+		},
+	}, "abc")
+	assert.Equal(t, "com.example", query.Get("maven.groupId"))
+	assert.Equal(t, "library", query.Get("maven.artifactId"))
+	assert.Equal(t, "1.1.0", query.Get("maven.baseVersion"))
+}
@@ -0,0 +1,77 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+const (
+	dockerEndpointPath  = "PATH"
+	dockerEndpointHTTP  = "HTTP"
+	dockerEndpointHTTPS = "HTTPS"
+)
+
+func getDockerRepository(m interface{}, name string) (*repository.Docker, error) {
+	client := api.FromMeta(m)
+
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+		if repo.Format != "docker" {
+			return nil, fmt.Errorf("repository %s is not a docker repository", name)
+		}
+		// hosted, proxy and group repositories share the docker attributes
+		var settings struct {
+			Docker repository.Docker `json:"docker"`
+		}
+		found, err := client.Repository.GetSettings(name, repo.Format, repo.Type, &settings)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			break
+		}
+		return &settings.Docker, nil
+	}
+	return nil, fmt.Errorf("repository %s does not exist", name)
+}
+
+// getDockerRegistryURL returns the registry URL of the repository for the
+// given endpoint, which must be configured as connector for HTTP and HTTPS
+func getDockerRegistryURL(client *api.Client, name string, docker *repository.Docker, endpoint string) (string, error) {
+	switch endpoint {
+	case dockerEndpointHTTP:
+		if docker.HTTPPort == nil || *docker.HTTPPort == 0 {
+			return "", fmt.Errorf("repository %s has no http connector", name)
+		}
+		return client.Docker.RegistryURL(name, *docker.HTTPPort, false)
+	case dockerEndpointHTTPS:
+		if docker.HTTPSPort == nil || *docker.HTTPSPort == 0 {
+			return "", fmt.Errorf("repository %s has no https connector", name)
+		}
+		return client.Docker.RegistryURL(name, *docker.HTTPSPort, true)
+	default:
+		return client.Docker.RegistryURL(name, 0, false)
+	}
+}
+
+func flattenDockerPlatforms(manifest *api.DockerManifest) []map[string]interface{} {
+	platforms := make([]map[string]interface{}, 0, len(manifest.Manifests))
+	for _, platformManifest := range manifest.Manifests {
+		platforms = append(platforms, map[string]interface{}{
+			"os":           platformManifest.Platform.OS,
+			"architecture": platformManifest.Platform.Architecture,
+			"variant":      platformManifest.Platform.Variant,
+			"digest":       platformManifest.Digest,
+			"media_type":   platformManifest.MediaType,
+		})
+	}
+	return platforms
+}
@@ -0,0 +1,50 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDockerRegistryURL(t *testing.T) {
+	c := api.NewClient(client.Config{URL: "https://nexus.example.com"})
+	docker := &repository.Docker{HTTPPort: tools.GetIntPointer(8082)}
+
+	registryURL, err := getDockerRegistryURL(c, "docker-hosted", docker, dockerEndpointPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://nexus.example.com/repository/docker-hosted", registryURL)
+
+	registryURL, err = getDockerRegistryURL(c, "docker-hosted", docker, dockerEndpointHTTP)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://nexus.example.com:8082", registryURL)
+
+	_, err = getDockerRegistryURL(c, "docker-hosted", docker, dockerEndpointHTTPS)
+	assert.EqualError(t, err, "repository docker-hosted has no https connector")
+}
+
+func TestFlattenDockerPlatforms(t *testing.T) {
+	platforms := flattenDockerPlatforms(&api.DockerManifest{
+		MediaType: api.OCIMediaTypeIndex,
+		Manifests: []api.DockerPlatformManifest{
+			{
+				Digest:    "sha256:aaaa",
+				MediaType: api.OCIMediaTypeManifest,
+				Platform:  api.DockerPlatform{OS: "linux", Architecture: "arm64", Variant: "v8"},
+			},
+		},
+	})
+
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"os":           "linux",
+			"architecture": "arm64",
+			"variant":      "v8",
+			"digest":       "sha256:aaaa",
+			"media_type":   api.OCIMediaTypeManifest,
+		},
+	}, platforms)
+}
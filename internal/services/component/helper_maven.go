@@ -0,0 +1,81 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+func getMavenRepositoryVersionPolicy(m interface{}, name string) (string, error) {
+	client := api.FromMeta(m)
+
+	repositories, err := client.Repository.List()
+	if err != nil {
+		return "", err
+	}
+	for _, repo := range repositories {
+		if repo.Name != name {
+			continue
+		}
+		if repo.Type != "hosted" && repo.Type != "proxy" {
+			return string(repository.MavenVersionPolicyRelease), nil
+		}
+		// hosted and proxy repositories share the maven attributes
+		var settings struct {
+			Maven repository.Maven `json:"maven"`
+		}
+		found, err := client.Repository.GetSettings(name, repo.Format, repo.Type, &settings)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			break
+		}
+		return string(settings.Maven.VersionPolicy), nil
+	}
+	return "", fmt.Errorf("repository %s does not exist", name)
+}
+
+// selectMavenVersion returns the latest version of metadata allowed by policy
+func selectMavenVersion(metadata *api.MavenMetadata, policy string) string {
+	versioning := metadata.Versioning
+	switch policy {
+	case string(repository.MavenVersionPolicyRelease):
+		if versioning.Release != "" {
+			return versioning.Release
+		}
+	case string(repository.MavenVersionPolicyMixed):
+		if versioning.Latest != "" {
+			return versioning.Latest
+		}
+	}
+
+	// nexus lists the versions in ascending order
+	for i := len(versioning.Versions) - 1; i >= 0; i-- {
+		version := versioning.Versions[i]
+		isSnapshot := strings.HasSuffix(version, "-SNAPSHOT")
+		switch {
+		case policy == string(repository.MavenVersionPolicyMixed),
+			policy == string(repository.MavenVersionPolicySnapshot) && isSnapshot,
+			policy == string(repository.MavenVersionPolicyRelease) && !isSnapshot:
+			return version
+		}
+	}
+	return ""
+}
+
+// getMavenSnapshotFileVersion resolves the timestamped version of the file
+// with the given classifier and extension from the metadata of a snapshot
+func getMavenSnapshotFileVersion(metadata *api.MavenMetadata, version string, classifier string, extension string) string {
+	for _, snapshotVersion := range metadata.Versioning.SnapshotVersions {
+		if snapshotVersion.Classifier == classifier && snapshotVersion.Extension == extension {
+			return snapshotVersion.Value
+		}
+	}
+	if snapshot := metadata.Versioning.Snapshot; snapshot != nil && snapshot.Timestamp != "" {
+		return fmt.Sprintf("%s-%s-%d", strings.TrimSuffix(version, "-SNAPSHOT"), snapshot.Timestamp, snapshot.BuildNumber)
+	}
+	return version
+}
@@ -0,0 +1,47 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectMavenVersion(t *testing.T) {
+	metadata := &api.MavenMetadata{
+		Versioning: api.MavenMetadataVersioning{
+			Versions: []string{"1.0.0", "1.1.0", "1.2.0-SNAPSHOT"},
+		},
+	}
+
+	assert.Equal(t, "1.1.0", selectMavenVersion(metadata, "RELEASE"))
+	assert.Equal(t, "1.2.0-SNAPSHOT", selectMavenVersion(metadata, "SNAPSHOT"))
+	assert.Equal(t, "1.2.0-SNAPSHOT", selectMavenVersion(metadata, "MIXED"))
+
+	metadata.Versioning.Release = "1.0.0"
+	metadata.Versioning.Latest = "1.1.0"
+	assert.Equal(t, "1.0.0", selectMavenVersion(metadata, "RELEASE"))
+	assert.Equal(t, "1.1.0", selectMavenVersion(metadata, "MIXED"))
+
+	metadata.Versioning.Versions = []string{"1.0.0"}
+	assert.Equal(t, "", selectMavenVersion(metadata, "SNAPSHOT"))
+}
+
+func TestGetMavenSnapshotFileVersion(t *testing.T) {
+	metadata := &api.MavenMetadata{
+		Versioning: api.MavenMetadataVersioning{
+			Snapshot: &api.MavenMetadataSnapshot{Timestamp: "20240102.030405", BuildNumber: 7},
+			SnapshotVersions: []api.MavenSnapshotVersion{
+				{Extension: "jar", Value: "1.2.0-20240102.030405-7"},
+				{Classifier: "sources", Extension: "jar", Value: "1.2.0-20240102.030405-6"},
+			},
+		},
+	}
+
+	assert.Equal(t, "1.2.0-20240102.030405-7", getMavenSnapshotFileVersion(metadata, "1.2.0-SNAPSHOT", "", "jar"))
+	assert.Equal(t, "1.2.0-20240102.030405-6", getMavenSnapshotFileVersion(metadata, "1.2.0-SNAPSHOT", "sources", "jar"))
+	assert.Equal(t, "1.2.0-20240102.030405-7", getMavenSnapshotFileVersion(metadata, "1.2.0-SNAPSHOT", "", "war"))
+
+	metadata.Versioning.Snapshot = nil
+	assert.Equal(t, "1.2.0-SNAPSHOT", getMavenSnapshotFileVersion(metadata, "1.2.0-SNAPSHOT", "", "war"))
+}
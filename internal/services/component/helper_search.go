@@ -0,0 +1,154 @@
+package component
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// searchFilters maps the filter attributes of the search data sources to the
+// query parameters of the search API
+var searchFilters = map[string]string{
+	"repository": "repository",
+	"format":     "format",
+	"group":      "group",
+	"name":       "name",
+	"version":    "version",
+	"md5":        "md5",
+	"sha1":       "sha1",
+	"sha256":     "sha256",
+	"keyword":    "q",
+}
+
+// searchSchema returns the filter attributes shared by the search data
+// sources, the result attribute is added by the data source
+func searchSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"repository": {
+			Description: "The name of the repository to search in",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"format": {
+			Description: "The format of the components, e.g. `maven2` or `npm`",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"group": {
+			Description: "The group of the components, e.g. the maven group id",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"name": {
+			Description: "The name of the components",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"version": {
+			Description: "The version of the components",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"md5": {
+			Description: "The MD5 checksum of an asset",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"sha1": {
+			Description: "The SHA-1 checksum of an asset",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"sha256": {
+			Description: "The SHA-256 checksum of an asset",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"keyword": {
+			Description: "A keyword matched against all fields",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"format_filters": {
+			Description: "Format specific filters passed to the search as is, e.g. `maven.extension`, `maven.classifier`, `npm.scope` or `docker.imageTag`",
+			Optional:    true,
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func getSearchQuery(d *schema.ResourceData) url.Values {
+	query := url.Values{}
+	for attribute, parameter := range searchFilters {
+		if value, ok := d.GetOk(attribute); ok {
+			query.Set(parameter, value.(string))
+		}
+	}
+	for parameter, value := range d.Get("format_filters").(map[string]interface{}) {
+		query.Set(parameter, value.(string))
+	}
+	return query
+}
+
+// getSearchID returns a stable id for the data source derived from query
+func getSearchID(query url.Values) string {
+	checksum := sha256.Sum256([]byte(query.Encode()))
+	return hex.EncodeToString(checksum[:])
+}
+
+// getStagingMoveQuery returns the query matching all components of
+// repository associated with tag
+func getStagingMoveQuery(repository string, tag string) url.Values {
+	return url.Values{
+		"repository": {repository},
+		"tag":        {tag},
+	}
+}
+
+// getMovedComponentIDs returns the ids of the components in destination
+// which match the coordinates of the moved components
+func getMovedComponentIDs(moved []api.StagingComponent, destination []api.Component) []string {
+	coordinates := make(map[api.StagingComponent]bool, len(moved))
+	for _, component := range moved {
+		coordinates[component] = true
+	}
+
+	ids := make([]string, 0, len(moved))
+	for _, component := range destination {
+		if coordinates[api.StagingComponent{Group: component.Group, Name: component.Name, Version: component.Version}] {
+			ids = append(ids, component.ID)
+		}
+	}
+	return ids
+}
+
+// findMovedComponentIDs searches destination for the moved components. The
+// ids of components change with their repository, so they can only be read
+// after the move, and the search index is updated asynchronously. It waits up
+// to componentSearchTimeout and returns the ids found until then.
+func findMovedComponentIDs(client *api.Client, destination string, tag string, moved []api.StagingComponent) ([]string, error) {
+	deadline := time.Now().Add(componentSearchTimeout)
+	for {
+		tagged, err := client.Component.SearchAll(getStagingMoveQuery(destination, tag))
+		if err != nil {
+			return nil, err
+		}
+		ids := getMovedComponentIDs(moved, tagged)
+		if len(ids) >= len(moved) {
+			return ids, nil
+		}
+		if time.Now().After(deadline) {
+			log.Printf("[WARN] Found %d of %d components moved to repository %s in the search index", len(ids), len(moved), destination)
+			return ids, nil
+		}
+		time.Sleep(time.Second)
+	}
+}
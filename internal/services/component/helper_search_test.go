@@ -0,0 +1,35 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMovedComponentIDs(t *testing.T) {
+	moved := []api.StagingComponent{
+		{Group: "com.example", Name: "service", Version: "1.1.0"},
+	}
+	destination := []api.Component{
+		{ID: "old", Group: "com.example", Name: "service", Version: "1.0.0"},
+		{ID: "new", Group: "com.example", Name: "service", Version: "1.1.0"},
+	}
+
+	assert.Equal(t, []string{"new"}, getMovedComponentIDs(moved, destination))
+	assert.Equal(t, []string{}, getMovedComponentIDs(nil, destination))
+}
+
+func TestFlattenTagAttributes(t *testing.T) {
+	attributes, err := flattenTagAttributes(map[string]interface{}{
+		"jvm":   "17",
+		"build": map[string]interface{}{"number": 42},
+		"ok":    true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"jvm":   "17",
+		"build": `{"number":42}`,
+		"ok":    "true",
+	}, attributes)
+}
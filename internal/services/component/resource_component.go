@@ -0,0 +1,306 @@
+package component
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+const (
+	componentFormatApt      = "apt"
+	componentFormatHelm     = "helm"
+	componentFormatMaven    = "maven2"
+	componentFormatNpm      = "npm"
+	componentFormatNuget    = "nuget"
+	componentFormatPypi     = "pypi"
+	componentFormatR        = "r"
+	componentFormatRaw      = "raw"
+	componentFormatRubygems = "rubygems"
+	componentFormatYum      = "yum"
+
+	// componentSearchTimeout is how long to wait for an uploaded component
+	// to show up in the search index
+	componentSearchTimeout = 60 * time.Second
+)
+
+var componentFormats = []string{
+	componentFormatApt,
+	componentFormatHelm,
+	componentFormatMaven,
+	componentFormatNpm,
+	componentFormatNuget,
+	componentFormatPypi,
+	componentFormatR,
+	componentFormatRaw,
+	componentFormatRubygems,
+	componentFormatYum,
+}
+
+func ResourceComponent() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to upload a component to a hosted repository.
+
+The component is uploaded again whenever the content of an asset changes, which is detected by the SHA-256 of the local files. The component is deleted on destroy.
+
+The upload is limited by ` + "`timeouts.create`" + `, which defaults to 30 minutes, instead of the timeout of other requests to nexus.`,
+
+		CreateContext: resourceComponentCreate,
+		Read:          resourceComponentRead,
+		Delete:        resourceComponentDelete,
+		CustomizeDiff: resourceComponentCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the hosted repository the component is uploaded to",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"format": {
+				Description:  "The format of the repository, possible values: `" + strings.Join(componentFormats, "`, `") + "`",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(componentFormats, false),
+			},
+			"raw": {
+				Description: "Fields of components in raw repositories, required for format `raw`",
+				ForceNew:    true,
+				MaxItems:    1,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Description: "The directory the assets are uploaded to",
+							ForceNew:    true,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			"maven2": {
+				Description: "Fields of components in maven repositories, required for format `maven2`",
+				ForceNew:    true,
+				MaxItems:    1,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Description: "The group id of the component",
+							ForceNew:    true,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						"artifact_id": {
+							Description: "The artifact id of the component",
+							ForceNew:    true,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						"version": {
+							Description: "The version of the component",
+							ForceNew:    true,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						"generate_pom": {
+							Default:     false,
+							Description: "Whether nexus generates a POM file for the component, defaults to `false` if unset",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						"packaging": {
+							Description: "The packaging of the component, used in the generated POM file",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			"yum": {
+				Description: "Fields of components in yum repositories, only allowed for format `yum`",
+				ForceNew:    true,
+				MaxItems:    1,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Description: "The directory the asset is uploaded to",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			"asset": {
+				Description: "The assets of the component. Formats `raw` and `maven2` support multiple assets, all other formats exactly one",
+				ForceNew:    true,
+				MinItems:    1,
+				Required:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Description: "The path of the local file which is uploaded",
+							ForceNew:    true,
+							Required:    true,
+							Type:        schema.TypeString,
+						},
+						"filename": {
+							Description: "The filename of the asset, required for format `raw`, optional for format `yum`",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"extension": {
+							Description: "The extension of the asset, required for format `maven2`",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"classifier": {
+							Description: "The classifier of the asset, only allowed for format `maven2`",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						"path_id": {
+							Description: "The path of the package in the repository, required for format `r`",
+							ForceNew:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			"asset_sha256": {
+				Description: "The SHA-256 checksums of the local files, in the order of `asset`",
+				Computed:    true,
+				ForceNew:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"group": {
+				Description: "The group of the component in nexus",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"name": {
+				Description: "The name of the component in nexus",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"version": {
+				Description: "The version of the component in nexus",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceComponentCreate(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := api.FromMeta(m)
+
+	upload, err := getComponentUpload(resourceData.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	checksums, err := getAssetSHA256(resourceData.Get("asset").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the context is limited by the create timeout of the resource
+	if err := client.Component.Upload(ctx, upload); err != nil {
+		return diag.FromErr(err)
+	}
+
+	component, err := findUploadedComponent(client, getUploadedComponentQuery(upload, checksums[0]))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(component.ID)
+	resourceData.Set("asset_sha256", checksums)
+	return diag.FromErr(resourceComponentRead(resourceData, m))
+}
+
+func resourceComponentRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	component, err := client.Component.Get(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	if component == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	resourceData.Set("repository", component.Repository)
+	resourceData.Set("group", component.Group)
+	resourceData.Set("name", component.Name)
+	resourceData.Set("version", component.Version)
+	return nil
+}
+
+func resourceComponentDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.Component.Delete(resourceData.Id()); err != nil {
+		return err
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+// resourceComponentCustomizeDiff validates the fields of the configured
+// format and replaces the component if the content of a local file changed
+func resourceComponentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("format") || !diff.NewValueKnown("asset") || !diff.NewValueKnown("raw") || !diff.NewValueKnown("maven2") || !diff.NewValueKnown("yum") {
+		return nil
+	}
+
+	if _, err := getComponentUpload(diff.Get); err != nil {
+		return err
+	}
+
+	checksums, err := getAssetSHA256(diff.Get("asset").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	old, _ := diff.GetChange("asset_sha256")
+	if diff.Id() == "" || reflect.DeepEqual(tools.InterfaceSliceToStringSlice(old.([]interface{})), checksums) {
+		return nil
+	}
+	if err := diff.SetNew("asset_sha256", checksums); err != nil {
+		return err
+	}
+	return diff.ForceNew("asset_sha256")
+}
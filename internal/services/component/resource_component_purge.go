@@ -0,0 +1,124 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+const componentPurgeDefaultMaxDeletions = 100
+
+func ResourceComponentPurge() *schema.Resource {
+	resourceSchema := searchSchema()
+	for _, attribute := range resourceSchema {
+		attribute.ForceNew = true
+	}
+	// a purge is always limited to a single repository
+	resourceSchema["repository"].Optional = false
+	resourceSchema["repository"].Required = true
+	resourceSchema["version"].Description = "The version of the components, may contain wildcards, e.g. `*-SNAPSHOT`"
+
+	resourceSchema["id"] = common.ResourceID
+	resourceSchema["triggers"] = &schema.Schema{
+		Description: "Arbitrary values which cause the purge to run again when changed",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		ForceNew: true,
+		Optional: true,
+		Type:     schema.TypeMap,
+	}
+	resourceSchema["dry_run"] = &schema.Schema{
+		Default:     false,
+		Description: "Only report the components matching the search without deleting them, defaults to `false` if unset",
+		ForceNew:    true,
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+	resourceSchema["max_deletions"] = &schema.Schema{
+		Default:      componentPurgeDefaultMaxDeletions,
+		Description:  "The maximum number of components a purge may delete. If more components match the search, nothing is deleted and the apply fails. Defaults to `100` if unset",
+		ForceNew:     true,
+		Optional:     true,
+		Type:         schema.TypeInt,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	resourceSchema["component_ids"] = &schema.Schema{
+		Description: "The ids of the components matching the search",
+		Computed:    true,
+		Type:        schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	resourceSchema["deleted_count"] = &schema.Schema{
+		Description: "The number of components deleted, `0` for a dry run",
+		Computed:    true,
+		Type:        schema.TypeInt,
+	}
+
+	return &schema.Resource{
+		Description: `Use this resource to delete all components of a repository matching a search, e.g. the snapshots of a retired project.
+
+The components are deleted on creation and whenever the search, ` + "`triggers`" + ` or the other arguments change. Destroying the resource does not change anything in nexus.
+
+!> Deleted components can not be restored. Use ` + "`dry_run`" + ` to review the matching components first.`,
+
+		Create: resourceComponentPurgeCreate,
+		Read:   resourceComponentPurgeRead,
+		Delete: resourceComponentPurgeDelete,
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceComponentPurgeCreate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+	query := getSearchQuery(d)
+
+	components, err := client.Component.SearchAll(query)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(components))
+	for _, component := range components {
+		ids = append(ids, component.ID)
+	}
+
+	dryRun := d.Get("dry_run").(bool)
+	maxDeletions := d.Get("max_deletions").(int)
+	if !dryRun && len(ids) > maxDeletions {
+		return fmt.Errorf("%d components in repository %s match the search, which is more than max_deletions %d, no component was deleted", len(ids), d.Get("repository").(string), maxDeletions)
+	}
+
+	deleted := 0
+	if !dryRun {
+		for _, id := range ids {
+			if err := client.Component.Delete(id); err != nil {
+				return fmt.Errorf("deleted %d of %d components: %v", deleted, len(ids), err)
+			}
+			deleted++
+		}
+	}
+
+	d.SetId(getSearchID(query))
+	d.Set("deleted_count", deleted)
+	if err := d.Set("component_ids", tools.StringSliceToInterfaceSlice(ids)); err != nil {
+		return fmt.Errorf("error reading component ids: %s", err)
+	}
+	return nil
+}
+
+func resourceComponentPurgeRead(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func resourceComponentPurgeDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
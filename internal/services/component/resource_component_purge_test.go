@@ -0,0 +1,85 @@
+package component_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceComponentPurge(t *testing.T) {
+	resourceName := "nexus_component_purge.acceptance"
+	repoName := fmt.Sprintf("acceptance-component-purge-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "build.log")
+	writeComponentSource(t, source, "build output")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceComponentPurgeConfig(repoName, source, true, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "component_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_count", "0"),
+				),
+			},
+			{
+				Config:      testAccResourceComponentPurgeConfig(repoName, source, false, 1),
+				ExpectError: regexp.MustCompile("2 components in repository .* match the search, which is more than max_deletions 1"),
+			},
+			{
+				Config: testAccResourceComponentPurgeConfig(repoName, source, false, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "component_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_count", "2"),
+				),
+				// the purged components are recreated by the next plan
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceComponentPurgeConfig(repoName string, source string, dryRun bool, maxDeletions int) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_component" "acceptance" {
+	for_each = toset(["build-1", "build-2"])
+
+	repository = nexus_repository_raw_hosted.acceptance.name
+	format     = "raw"
+
+	raw {
+		directory = "logs/${each.key}"
+	}
+
+	asset {
+		source   = "%s"
+		filename = "build.log"
+	}
+}
+
+resource "nexus_component_purge" "acceptance" {
+	depends_on = [nexus_component.acceptance]
+
+	repository    = nexus_repository_raw_hosted.acceptance.name
+	dry_run       = %t
+	max_deletions = %d
+}
+`, repoName, source, dryRun, maxDeletions)
+}
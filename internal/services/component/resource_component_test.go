@@ -0,0 +1,162 @@
+package component_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceComponentRaw(t *testing.T) {
+	resName := "nexus_component.acceptance"
+	repoName := fmt.Sprintf("acceptance-component-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "hello.sh")
+
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeComponentSource(t, source, "echo hello") },
+				Config:    testAccResourceComponentRawConfig(repoName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "repository", repoName),
+					resource.TestCheckResourceAttr(resName, "name", "scripts/hello.sh"),
+					resource.TestCheckResourceAttr(resName, "asset_sha256.#", "1"),
+					resource.TestCheckResourceAttr(resName, "asset_sha256.0", sha256Hex("echo hello")),
+					storeComponentID(resName, &firstID),
+				),
+			},
+			{
+				// Changing the local file uploads the component again
+				PreConfig: func() { writeComponentSource(t, source, "echo hello world") },
+				Config:    testAccResourceComponentRawConfig(repoName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "asset_sha256.0", sha256Hex("echo hello world")),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resName].Primary.ID == firstID {
+							return fmt.Errorf("component was not uploaded again after the content changed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceComponentMaven(t *testing.T) {
+	resName := "nexus_component.acceptance"
+	repoName := fmt.Sprintf("acceptance-component-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "library.jar")
+	writeComponentSource(t, source, "not really a jar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceComponentMavenConfig(repoName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "group", "com.example"),
+					resource.TestCheckResourceAttr(resName, "name", "library"),
+					resource.TestCheckResourceAttr(resName, "version", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func writeComponentSource(t *testing.T, path string, content string) {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func sha256Hex(content string) string {
+	checksum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(checksum[:])
+}
+
+func storeComponentID(resName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*id = s.RootModule().Resources[resName].Primary.ID
+		return nil
+	}
+}
+
+func testAccResourceComponentRawConfig(repoName string, source string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_component" "acceptance" {
+	repository = nexus_repository_raw_hosted.acceptance.name
+	format     = "raw"
+
+	raw {
+		directory = "scripts"
+	}
+
+	asset {
+		source   = "%s"
+		filename = "hello.sh"
+	}
+}
+`, repoName, source)
+}
+
+func testAccResourceComponentMavenConfig(repoName string, source string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_maven_hosted" "acceptance" {
+	name = "%s"
+
+	maven {
+		version_policy = "RELEASE"
+		layout_policy  = "STRICT"
+	}
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_component" "acceptance" {
+	repository = nexus_repository_maven_hosted.acceptance.name
+	format     = "maven2"
+
+	maven2 {
+		group_id     = "com.example"
+		artifact_id  = "library"
+		version      = "1.0.0"
+		generate_pom = true
+		packaging    = "jar"
+	}
+
+	asset {
+		source    = "%s"
+		extension = "jar"
+	}
+}
+`, repoName, source)
+}
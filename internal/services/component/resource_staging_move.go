@@ -0,0 +1,107 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func ResourceStagingMove() *schema.Resource {
+	resourceSchema := searchSchema()
+	for _, attribute := range resourceSchema {
+		attribute.ForceNew = true
+	}
+	// components are always moved from a single staging repository
+	resourceSchema["repository"].Optional = false
+	resourceSchema["repository"].Required = true
+	resourceSchema["repository"].Description = "The name of the repository the components are moved from"
+
+	resourceSchema["id"] = common.ResourceID
+	resourceSchema["tag"] = &schema.Schema{
+		Description: "The name of the tag the components matching the search are associated with before they are moved",
+		ForceNew:    true,
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+	resourceSchema["destination"] = &schema.Schema{
+		Description: "The name of the repository the components are moved to",
+		ForceNew:    true,
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+	resourceSchema["triggers"] = &schema.Schema{
+		Description: "Arbitrary values which cause the components to be moved again when changed",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		ForceNew: true,
+		Optional: true,
+		Type:     schema.TypeMap,
+	}
+	resourceSchema["component_ids"] = &schema.Schema{
+		Description: "The ids of the moved components in the destination repository. They are searched after the move, components which are not in the search index of nexus within 60 seconds are missing",
+		Computed:    true,
+		Type:        schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to promote components, e.g. from a staging to a release repository. The components matching the search are associated with ` + "`tag`" + ` and all components of the repository with this tag are moved to ` + "`destination`" + `.
+
+The components are moved on creation and whenever the search, ` + "`triggers`" + ` or the other arguments change. Destroying the resource does not change anything in nexus.`,
+
+		Create: resourceStagingMoveCreate,
+		Read:   resourceStagingMoveRead,
+		Delete: resourceStagingMoveDelete,
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceStagingMoveCreate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	tag := d.Get("tag").(string)
+	destination := d.Get("destination").(string)
+	query := getSearchQuery(d)
+
+	if _, err := client.Tag.Associate(tag, query); err != nil {
+		return err
+	}
+
+	moveQuery := getStagingMoveQuery(d.Get("repository").(string), tag)
+	moved, err := client.Staging.Move(destination, moveQuery)
+	if err != nil {
+		return err
+	}
+
+	// the staging API only returns the coordinates of the moved components
+	ids, err := findMovedComponentIDs(client, destination, tag, moved)
+	if err != nil {
+		return err
+	}
+
+	query.Set("tag", tag)
+	query.Set("destination", destination)
+	d.SetId(getSearchID(query))
+	if err := d.Set("component_ids", tools.StringSliceToInterfaceSlice(ids)); err != nil {
+		return fmt.Errorf("error reading component ids: %s", err)
+	}
+	return nil
+}
+
+func resourceStagingMoveRead(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func resourceStagingMoveDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
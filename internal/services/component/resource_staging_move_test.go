@@ -0,0 +1,82 @@
+package component_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccResourceStagingMove(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resourceName := "nexus_staging_move.acceptance"
+	name := fmt.Sprintf("acceptance-staging-%s", acctest.RandString(10))
+	source := filepath.Join(t.TempDir(), "app.tar.gz")
+	writeComponentSource(t, source, "release artifact")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStagingMoveConfig(name, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "repository", name+"-staging"),
+					resource.TestCheckResourceAttr(resourceName, "destination", name+"-release"),
+					resource.TestCheckResourceAttr(resourceName, "component_ids.#", "1"),
+				),
+				// the moved component is uploaded to the staging repository again by the next plan
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceStagingMoveConfig(name string, source string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	for_each = toset(["%[1]s-staging", "%[1]s-release"])
+
+	name = each.key
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = false
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_component" "acceptance" {
+	repository = nexus_repository_raw_hosted.acceptance["%[1]s-staging"].name
+	format     = "raw"
+
+	raw {
+		directory = "app"
+	}
+
+	asset {
+		source   = "%[2]s"
+		filename = "app.tar.gz"
+	}
+}
+
+resource "nexus_tag" "acceptance" {
+	name = "%[1]s"
+}
+
+resource "nexus_staging_move" "acceptance" {
+	depends_on = [nexus_component.acceptance]
+
+	repository  = nexus_repository_raw_hosted.acceptance["%[1]s-staging"].name
+	destination = nexus_repository_raw_hosted.acceptance["%[1]s-release"].name
+	tag         = nexus_tag.acceptance.name
+}
+`, name, source)
+}
@@ -0,0 +1,142 @@
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceTag() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to create a tag, which can be associated with components, e.g. to stage them with ` + "`nexus_staging_move`.",
+
+		Create: resourceTagCreate,
+		Read:   resourceTagRead,
+		Update: resourceTagUpdate,
+		Delete: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the tag",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"attributes": {
+				Description: "Arbitrary attributes of the tag, e.g. the build number. Attributes set to other values than strings outside of terraform are read as JSON",
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"first_created": {
+				Description: "When the tag was created",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"last_updated": {
+				Description: "When the tag was last updated",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func getTagFromResourceData(d *schema.ResourceData) api.Tag {
+	tag := api.Tag{
+		Name:       d.Get("name").(string),
+		Attributes: make(map[string]interface{}),
+	}
+	for key, value := range d.Get("attributes").(map[string]interface{}) {
+		tag.Attributes[key] = value.(string)
+	}
+	return tag
+}
+
+func flattenTagAttributes(attributes map[string]interface{}) (map[string]string, error) {
+	flattened := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		if s, ok := value.(string); ok {
+			flattened[key] = s
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		flattened[key] = string(encoded)
+	}
+	return flattened, nil
+}
+
+func resourceTagCreate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	tag := getTagFromResourceData(d)
+	if err := client.Tag.Create(&tag); err != nil {
+		return err
+	}
+
+	d.SetId(tag.Name)
+	return resourceTagRead(d, m)
+}
+
+func resourceTagRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	tag, err := client.Tag.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if tag == nil {
+		d.SetId("")
+		return nil
+	}
+
+	attributes, err := flattenTagAttributes(tag.Attributes)
+	if err != nil {
+		return fmt.Errorf("error reading attributes: %s", err)
+	}
+
+	d.Set("name", tag.Name)
+	d.Set("first_created", tag.FirstCreated)
+	d.Set("last_updated", tag.LastUpdated)
+	if err := d.Set("attributes", attributes); err != nil {
+		return fmt.Errorf("error reading attributes: %s", err)
+	}
+	return nil
+}
+
+func resourceTagUpdate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	tag := getTagFromResourceData(d)
+	if err := client.Tag.Update(&tag); err != nil {
+		return err
+	}
+
+	return resourceTagRead(d, m)
+}
+
+func resourceTagDelete(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.Tag.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package component_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccResourceTag(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resourceName := "nexus_tag.acceptance"
+	tagName := fmt.Sprintf("acceptance-tag-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagConfig(tagName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", tagName),
+					resource.TestCheckResourceAttr(resourceName, "name", tagName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.build", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "first_created"),
+				),
+			},
+			{
+				Config: testAccResourceTagConfig(tagName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.build", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTagConfig(name string, build string) string {
+	return fmt.Sprintf(`
+resource "nexus_tag" "acceptance" {
+	name = "%s"
+
+	attributes = {
+		build  = "%s"
+		branch = "main"
+	}
+}
+`, name, build)
+}
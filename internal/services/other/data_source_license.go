@@ -0,0 +1,40 @@
+package other
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func DataSourceLicense() *schema.Resource {
+	dataSourceSchema := licenseSchema()
+	dataSourceSchema["id"] = common.DataSourceID
+	dataSourceSchema["installed"] = &schema.Schema{
+		Description: "Whether a license is installed, i.e. whether the PRO features of nexus are available",
+		Computed:    true,
+		Type:        schema.TypeBool,
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to read the installed license of the nexus repository manager.",
+
+		Read:   dataSourceLicenseRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceLicenseRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	license, err := client.License.Get()
+	if err != nil {
+		return err
+	}
+
+	d.SetId("license")
+	d.Set("installed", license != nil)
+	if license == nil {
+		license = &api.License{}
+	}
+	return setLicenseToResourceData(license, d)
+}
@@ -0,0 +1,44 @@
+package other_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccDataSourceLicense(t *testing.T) {
+	dataSourceName := "data.nexus_license.acceptance"
+	installed := tools.GetEnv("SKIP_PRO_TESTS", "false") != "true"
+
+	checks := []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(dataSourceName, "id", "license"),
+		resource.TestCheckResourceAttr(dataSourceName, "installed", strconv.FormatBool(installed)),
+	}
+	if installed {
+		checks = append(checks,
+			resource.TestCheckResourceAttrSet(dataSourceName, "licensee"),
+			resource.TestCheckResourceAttrSet(dataSourceName, "expiration_date"),
+			resource.TestCheckResourceAttrSet(dataSourceName, "fingerprint"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLicenseConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLicenseConfig() string {
+	return `
+data "nexus_license" "acceptance" {}
+`
+}
@@ -1,5 +1,12 @@
 package other
 
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
 func flattenCriteria(criteria *Criteria) []map[string]interface{} {
 	if criteria == nil {
 		return nil
@@ -12,3 +19,25 @@ func flattenCriteria(criteria *Criteria) []map[string]interface{} {
 		},
 	}
 }
+
+func flattenHTTPSystemSettingsProxy(proxy *api.HTTPSettingsProxy, d *schema.ResourceData, key string) []map[string]interface{} {
+	if proxy == nil {
+		return nil
+	}
+	data := map[string]interface{}{
+		"host": proxy.Host,
+		"port": proxy.Port,
+	}
+	if proxy.Authentication != nil {
+		data["authentication"] = []map[string]interface{}{
+			{
+				"ntlm_domain": proxy.Authentication.NTLMDomain,
+				"ntlm_host":   proxy.Authentication.NTLMHost,
+				"type":        string(proxy.Authentication.Type),
+				"username":    proxy.Authentication.Username,
+				"password":    d.Get(fmt.Sprintf("%s.0.authentication.0.password", key)).(string),
+			},
+		}
+	}
+	return []map[string]interface{}{data}
+}
@@ -0,0 +1,34 @@
+package other
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getLicenseFingerprint returns the fingerprint nexus reports for the base64
+// encoded license file, which is the SHA-1 hash of the file
+func getLicenseFingerprint(licenseBase64 string) (string, error) {
+	content, err := base64.StdEncoding.DecodeString(licenseBase64)
+	if err != nil {
+		return "", fmt.Errorf("could not decode license: %v", err)
+	}
+	hash := sha1.Sum(content)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// suppressLicenseDiff ignores a changed license file if nexus reports the
+// same fingerprint, e.g. after an import, which can not read the file. If
+// the fingerprints can not be compared, the license is installed again.
+func suppressLicenseDiff(k, old, new string, d *schema.ResourceData) bool {
+	installed := d.Get("fingerprint").(string)
+	if installed == "" {
+		return false
+	}
+	fingerprint, err := getLicenseFingerprint(new)
+	return err == nil && strings.EqualFold(fingerprint, installed)
+}
@@ -0,0 +1,24 @@
+package other
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressLicenseDiff(t *testing.T) {
+	license := base64.StdEncoding.EncodeToString([]byte("license"))
+	fingerprint, err := getLicenseFingerprint(license)
+	assert.NoError(t, err)
+	assert.Equal(t, "23457129b871d690a3b4d86a51ded0c27ba29a9c", fingerprint)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceLicense().Schema, map[string]interface{}{})
+	assert.False(t, suppressLicenseDiff("license_base64", "", license, resourceData))
+
+	resourceData.Set("fingerprint", fingerprint)
+	assert.True(t, suppressLicenseDiff("license_base64", "", license, resourceData))
+	assert.False(t, suppressLicenseDiff("license_base64", license, base64.StdEncoding.EncodeToString([]byte("renewed")), resourceData))
+	assert.False(t, suppressLicenseDiff("license_base64", "", "invalid", resourceData))
+}
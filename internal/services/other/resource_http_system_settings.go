@@ -0,0 +1,191 @@
+package other
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func ResourceHTTPSystemSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to configure the global HTTP client settings of the nexus repository manager, which are used for all outbound connections, e.g. of proxy repositories.
+
+!> This resource can only be used **once** for a nexus
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create: resourceHTTPSystemSettingsUpdate,
+		Read:   resourceHTTPSystemSettingsRead,
+		Update: resourceHTTPSystemSettingsUpdate,
+		Delete: resourceHTTPSystemSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"user_agent_suffix": {
+				Description: "Custom fragment to append to User-Agent header in HTTP requests",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"timeout": {
+				Description:  "Seconds to wait for activity before stopping and retrying the connection, defaults to `20` if unset",
+				Optional:     true,
+				Default:      20,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(1, 3600),
+			},
+			"retries": {
+				Description:  "Total retries if the initial connection attempt suffers a timeout, defaults to `2` if unset",
+				Optional:     true,
+				Default:      2,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"http_proxy":  resourceHTTPSystemSettingsProxy("Proxy used for HTTP requests", nil),
+			"https_proxy": resourceHTTPSystemSettingsProxy("Proxy used for HTTPS requests, requires `http_proxy` to be set", []string{"http_proxy"}),
+			"non_proxy_hosts": {
+				Description:  "Hosts which are connected to without proxy, requires `http_proxy` to be set",
+				Optional:     true,
+				Type:         schema.TypeSet,
+				RequiredWith: []string{"http_proxy"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceHTTPSystemSettingsProxy(description string, requiredWith []string) *schema.Schema {
+	return &schema.Schema{
+		Description:  description,
+		Optional:     true,
+		MaxItems:     1,
+		RequiredWith: requiredWith,
+		Type:         schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host": {
+					Description: "The host of the proxy server",
+					Required:    true,
+					Type:        schema.TypeString,
+				},
+				"port": {
+					Description:  "The port of the proxy server",
+					Required:     true,
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"authentication": repositorySchema.ResourceHTTPClientAuthentication,
+			},
+		},
+	}
+}
+
+func getHTTPSystemSettingsFromResourceData(d *schema.ResourceData) api.HTTPSettings {
+	settings := api.HTTPSettings{
+		UserAgentSuffix: d.Get("user_agent_suffix").(string),
+		Timeout:         tools.GetIntPointer(d.Get("timeout").(int)),
+		Retries:         tools.GetIntPointer(d.Get("retries").(int)),
+		HTTPProxy:       getHTTPSystemSettingsProxy(d.Get("http_proxy").([]interface{})),
+		HTTPSProxy:      getHTTPSystemSettingsProxy(d.Get("https_proxy").([]interface{})),
+		NonProxyHosts:   tools.ConvertStringSet(d.Get("non_proxy_hosts").(*schema.Set)),
+	}
+	return settings
+}
+
+func getHTTPSystemSettingsProxy(proxyList []interface{}) *api.HTTPSettingsProxy {
+	if len(proxyList) != 1 || proxyList[0] == nil {
+		return nil
+	}
+	proxyConfig := proxyList[0].(map[string]interface{})
+
+	proxy := api.HTTPSettingsProxy{
+		Host: proxyConfig["host"].(string),
+		Port: proxyConfig["port"].(int),
+	}
+
+	authList := proxyConfig["authentication"].([]interface{})
+	if len(authList) == 1 && authList[0] != nil {
+		authConfig := authList[0].(map[string]interface{})
+
+		proxy.Authentication = &repository.HTTPClientAuthentication{
+			NTLMDomain: authConfig["ntlm_domain"].(string),
+			NTLMHost:   authConfig["ntlm_host"].(string),
+			Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+			Username:   authConfig["username"].(string),
+			Password:   authConfig["password"].(string),
+		}
+	}
+
+	return &proxy
+}
+
+func setHTTPSystemSettingsToResourceData(settings *api.HTTPSettings, d *schema.ResourceData) error {
+	d.SetId("http")
+	d.Set("user_agent_suffix", settings.UserAgentSuffix)
+	if settings.Timeout != nil {
+		d.Set("timeout", *settings.Timeout)
+	}
+	if settings.Retries != nil {
+		d.Set("retries", *settings.Retries)
+	}
+
+	if err := d.Set("http_proxy", flattenHTTPSystemSettingsProxy(settings.HTTPProxy, d, "http_proxy")); err != nil {
+		return fmt.Errorf("error reading http proxy: %s", err)
+	}
+	if err := d.Set("https_proxy", flattenHTTPSystemSettingsProxy(settings.HTTPSProxy, d, "https_proxy")); err != nil {
+		return fmt.Errorf("error reading https proxy: %s", err)
+	}
+	if err := d.Set("non_proxy_hosts", tools.StringSliceToInterfaceSlice(settings.NonProxyHosts)); err != nil {
+		return fmt.Errorf("error reading non proxy hosts: %s", err)
+	}
+	return nil
+}
+
+func resourceHTTPSystemSettingsRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	settings, err := client.HTTPSettings.Get()
+	if errors.Is(err, api.ErrScriptNotStored) {
+		log.Printf("[WARN] Could not refresh HTTP system settings: %v", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return setHTTPSystemSettingsToResourceData(settings, d)
+}
+
+func resourceHTTPSystemSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	settings := getHTTPSystemSettingsFromResourceData(d)
+	if err := client.HTTPSettings.Update(&settings); err != nil {
+		return err
+	}
+
+	return resourceHTTPSystemSettingsRead(d, m)
+}
+
+func resourceHTTPSystemSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.HTTPSettings.Reset(); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,125 @@
+package other_test
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+const (
+	resourceHTTPSystemSettingsTemplateString = `
+resource "nexus_http_system_settings" "acceptance" {
+	user_agent_suffix = "{{ .UserAgentSuffix }}"
+	timeout           = {{ deref .Timeout }}
+	retries           = {{ deref .Retries }}
+{{- with .HTTPProxy }}
+
+	http_proxy {
+		host = "{{ .Host }}"
+		port = {{ .Port }}
+	{{- with .Authentication }}
+
+		authentication {
+			type        = "{{ .Type }}"
+			username    = "{{ .Username }}"
+			password    = "{{ .Password }}"
+			ntlm_domain = "{{ .NTLMDomain }}"
+			ntlm_host   = "{{ .NTLMHost }}"
+		}
+	{{- end }}
+	}
+{{- end }}
+{{- with .HTTPSProxy }}
+
+	https_proxy {
+		host = "{{ .Host }}"
+		port = {{ .Port }}
+	}
+{{- end }}
+{{- if .NonProxyHosts }}
+
+	non_proxy_hosts = [
+	{{- range .NonProxyHosts }}
+		"{{ . }}",
+	{{- end }}
+	]
+{{- end }}
+}
+`
+)
+
+func testAccResourceHTTPSystemSettingsConfig(settings api.HTTPSettings) string {
+	buf := &bytes.Buffer{}
+	resourceTemplate := template.Must(template.New("HTTPSystemSettings").Funcs(acceptance.TemplateFuncMap).Parse(resourceHTTPSystemSettingsTemplateString))
+	if err := resourceTemplate.Execute(buf, settings); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestAccResourceHTTPSystemSettings(t *testing.T) {
+	resName := "nexus_http_system_settings.acceptance"
+
+	settings := api.HTTPSettings{
+		UserAgentSuffix: "acceptance",
+		Timeout:         tools.GetIntPointer(30),
+		Retries:         tools.GetIntPointer(3),
+		HTTPProxy: &api.HTTPSettingsProxy{
+			Host: "proxy.example.com",
+			Port: 3128,
+			Authentication: &repository.HTTPClientAuthentication{
+				Type:       repository.HTTPClientAuthenticationTypeNtlm,
+				Username:   "proxy-user",
+				Password:   "proxy-password",
+				NTLMDomain: "EXAMPLE",
+				NTLMHost:   "workstation",
+			},
+		},
+		HTTPSProxy: &api.HTTPSettingsProxy{
+			Host: "proxy.example.com",
+			Port: 3129,
+		},
+		NonProxyHosts: []string{"*.example.com", "localhost"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceHTTPSystemSettingsConfig(settings),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "http"),
+					resource.TestCheckResourceAttr(resName, "user_agent_suffix", settings.UserAgentSuffix),
+					resource.TestCheckResourceAttr(resName, "timeout", strconv.Itoa(*settings.Timeout)),
+					resource.TestCheckResourceAttr(resName, "retries", strconv.Itoa(*settings.Retries)),
+					resource.TestCheckResourceAttr(resName, "http_proxy.#", "1"),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.host", settings.HTTPProxy.Host),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.port", strconv.Itoa(settings.HTTPProxy.Port)),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.authentication.0.type", string(settings.HTTPProxy.Authentication.Type)),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.authentication.0.username", settings.HTTPProxy.Authentication.Username),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.authentication.0.password", settings.HTTPProxy.Authentication.Password),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.authentication.0.ntlm_domain", settings.HTTPProxy.Authentication.NTLMDomain),
+					resource.TestCheckResourceAttr(resName, "http_proxy.0.authentication.0.ntlm_host", settings.HTTPProxy.Authentication.NTLMHost),
+					resource.TestCheckResourceAttr(resName, "https_proxy.#", "1"),
+					resource.TestCheckResourceAttr(resName, "https_proxy.0.port", strconv.Itoa(settings.HTTPSProxy.Port)),
+					resource.TestCheckResourceAttr(resName, "non_proxy_hosts.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateId:           "http",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_proxy.0.authentication.0.password"},
+			},
+		},
+	})
+}
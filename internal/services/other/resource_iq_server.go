@@ -0,0 +1,194 @@
+package other
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func ResourceIQServer() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to configure the connection of the nexus repository manager to a Sonatype IQ server, which is required for Sonatype Firewall.
+
+Destroying the resource disables the connection.
+
+!> This resource can only be used **once** for a nexus`,
+
+		Create: resourceIQServerUpdate,
+		Read:   resourceIQServerRead,
+		Update: resourceIQServerUpdate,
+		Delete: resourceIQServerDelete,
+
+		CustomizeDiff: resourceIQServerCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"enabled": {
+				Description: "Whether the connection to the IQ server is enabled, defaults to `true` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Description:  "The URL of the IQ server",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"authentication_type": {
+				Description:  "The authentication method, possible values: `USER` or `PKI`, defaults to `USER` if unset",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      api.IQServerAuthenticationTypeUser,
+				ValidateFunc: validation.StringInSlice([]string{api.IQServerAuthenticationTypeUser, api.IQServerAuthenticationTypePKI}, false),
+			},
+			"username": {
+				Description: "The username used to authenticate against the IQ server, required for authentication type `USER`",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description: "The password used to authenticate against the IQ server, required for authentication type `USER`",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"use_trust_store": {
+				Description: "Use certificates stored in the Nexus truststore to connect to the IQ server, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"timeout": {
+				Description:  "Seconds to wait for activity before stopping and retrying the connection, uses the global HTTP timeout if unset",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 3600),
+			},
+			"properties": {
+				Description: "Additional properties of the IQ server connection",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"show_link": {
+				Description: "Show the link to the IQ server dashboard in the nexus user interface, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func getIQServerFromResourceData(d *schema.ResourceData) api.IQServer {
+	iqServer := api.IQServer{
+		Enabled:             d.Get("enabled").(bool),
+		ShowLink:            d.Get("show_link").(bool),
+		URL:                 d.Get("url").(string),
+		AuthenticationType:  d.Get("authentication_type").(string),
+		Username:            d.Get("username").(string),
+		Password:            d.Get("password").(string),
+		UseTrustStoreForURL: d.Get("use_trust_store").(bool),
+		Properties:          joinIQServerProperties(d.Get("properties").(map[string]interface{})),
+	}
+	if timeout, ok := d.GetOk("timeout"); ok {
+		iqServer.TimeoutSeconds = tools.GetIntPointer(timeout.(int))
+	}
+	return iqServer
+}
+
+func joinIQServerProperties(properties map[string]interface{}) string {
+	lines := make([]string, 0, len(properties))
+	for key, value := range properties {
+		lines = append(lines, fmt.Sprintf("%s=%s", key, value.(string)))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+func splitIQServerProperties(properties string) map[string]string {
+	result := make(map[string]string)
+	for _, line := range strings.Split(properties, "\n") {
+		if key, value, found := strings.Cut(strings.TrimSpace(line), "="); found {
+			result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return result
+}
+
+func setIQServerToResourceData(iqServer *api.IQServer, d *schema.ResourceData) error {
+	d.SetId("iq")
+	d.Set("enabled", iqServer.Enabled)
+	d.Set("show_link", iqServer.ShowLink)
+	d.Set("url", iqServer.URL)
+	d.Set("authentication_type", iqServer.AuthenticationType)
+	d.Set("username", iqServer.Username)
+	d.Set("use_trust_store", iqServer.UseTrustStoreForURL)
+	if iqServer.TimeoutSeconds != nil {
+		d.Set("timeout", *iqServer.TimeoutSeconds)
+	} else {
+		d.Set("timeout", nil)
+	}
+	if err := d.Set("properties", splitIQServerProperties(iqServer.Properties)); err != nil {
+		return fmt.Errorf("error reading properties: %s", err)
+	}
+	return nil
+}
+
+func resourceIQServerRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	iqServer, err := client.IQServer.Get()
+	if err != nil {
+		return err
+	}
+
+	return setIQServerToResourceData(iqServer, d)
+}
+
+func resourceIQServerUpdate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	iqServer := getIQServerFromResourceData(d)
+	if err := client.IQServer.Update(&iqServer); err != nil {
+		return err
+	}
+
+	return resourceIQServerRead(d, m)
+}
+
+func resourceIQServerDelete(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.IQServer.Disable(); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIQServerCustomizeDiff ensures username and password are set if the
+// IQ server authenticates with them
+func resourceIQServerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("authentication_type") || !diff.NewValueKnown("username") || !diff.NewValueKnown("password") {
+		return nil
+	}
+	if diff.Get("authentication_type").(string) == api.IQServerAuthenticationTypeUser && (diff.Get("username").(string) == "" || diff.Get("password").(string) == "") {
+		return fmt.Errorf("username and password are required for authentication type %s", api.IQServerAuthenticationTypeUser)
+	}
+	return nil
+}
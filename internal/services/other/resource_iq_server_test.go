@@ -0,0 +1,76 @@
+package other_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceIQServer(t *testing.T) {
+	resName := "nexus_iq_server.acceptance"
+	url := "http://iq.example.com:8070"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIQServerConfig(url, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "iq"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "url", url),
+					resource.TestCheckResourceAttr(resName, "authentication_type", "USER"),
+					resource.TestCheckResourceAttr(resName, "username", "admin"),
+					resource.TestCheckResourceAttr(resName, "timeout", "30"),
+					resource.TestCheckResourceAttr(resName, "show_link", "true"),
+					resource.TestCheckResourceAttr(resName, "properties.%", "1"),
+					resource.TestCheckResourceAttr(resName, "properties.procArch", "false"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateId:           "iq",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccResourceIQServerWithoutPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nexus_iq_server" "acceptance" {
+	url      = "http://iq.example.com:8070"
+	username = "admin"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("username and password are required for authentication type USER"),
+			},
+		},
+	})
+}
+
+func testAccResourceIQServerConfig(url string, timeout int) string {
+	return fmt.Sprintf(`
+resource "nexus_iq_server" "acceptance" {
+	url       = "%s"
+	username  = "admin"
+	password  = "admin123"
+	timeout   = %d
+	show_link = true
+
+	properties = {
+		procArch = "false"
+	}
+}`, url, timeout)
+}
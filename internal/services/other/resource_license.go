@@ -0,0 +1,177 @@
+package other
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+// licenseSchema contains the computed attributes of the resource and data source
+func licenseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"licensee": {
+			Description: "The company the license is issued to",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"contact_name": {
+			Description: "The name of the license contact",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"contact_email": {
+			Description: "The email address of the license contact",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"effective_date": {
+			Description: "The date the license becomes valid",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"expiration_date": {
+			Description: "The date the license expires",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"license_type": {
+			Description: "The type of the license",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"licensed_users": {
+			Description: "The number of licensed users",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"fingerprint": {
+			Description: "The fingerprint identifying the license",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"features": {
+			Description: "The licensed features",
+			Computed:    true,
+			Type:        schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func ResourceLicense() *schema.Resource {
+	resourceSchema := licenseSchema()
+	resourceSchema["id"] = common.ResourceID
+	resourceSchema["license_base64"] = &schema.Schema{
+		Description:      "The base64 encoded content of the license file, e.g. read with `filebase64`. A new license is installed in place, licenses are compared by their fingerprint",
+		DiffSuppressFunc: suppressLicenseDiff,
+		Required:         true,
+		Sensitive:        true,
+		Type:             schema.TypeString,
+		ValidateFunc:     validation.StringIsBase64,
+	}
+
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to install the license of the nexus repository manager. A changed license, e.g. a renewal, replaces the installed license without removing it first. The license is removed on destroy.
+
+!> This resource can only be used **once** for a nexus`,
+
+		Create: resourceLicenseCreate,
+		Read:   resourceLicenseRead,
+		Update: resourceLicenseUpdate,
+		Delete: resourceLicenseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func setLicenseToResourceData(license *api.License, d *schema.ResourceData) error {
+	d.Set("licensee", license.ContactCompany)
+	d.Set("contact_name", license.ContactName)
+	d.Set("contact_email", license.ContactEmail)
+	d.Set("effective_date", license.EffectiveDate)
+	d.Set("expiration_date", license.ExpirationDate)
+	d.Set("license_type", license.LicenseType)
+	d.Set("licensed_users", license.LicensedUsers)
+	d.Set("fingerprint", license.Fingerprint)
+
+	var features []string
+	for _, feature := range strings.Split(license.Features, ",") {
+		if feature = strings.TrimSpace(feature); feature != "" {
+			features = append(features, feature)
+		}
+	}
+	if err := d.Set("features", tools.StringSliceToInterfaceSlice(features)); err != nil {
+		return fmt.Errorf("error reading features: %s", err)
+	}
+	return nil
+}
+
+func installLicense(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	content, err := base64.StdEncoding.DecodeString(d.Get("license_base64").(string))
+	if err != nil {
+		return fmt.Errorf("could not decode license: %v", err)
+	}
+
+	_, err = client.License.Install(content)
+	return err
+}
+
+func resourceLicenseCreate(d *schema.ResourceData, m interface{}) error {
+	if err := installLicense(d, m); err != nil {
+		return err
+	}
+
+	d.SetId("license")
+	return resourceLicenseRead(d, m)
+}
+
+// resourceLicenseUpdate installs the new license, which replaces the
+// installed one, so that nexus is never left without license
+func resourceLicenseUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := installLicense(d, m); err != nil {
+		return err
+	}
+	return resourceLicenseRead(d, m)
+}
+
+func resourceLicenseRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	license, err := client.License.Get()
+	if err != nil {
+		return err
+	}
+
+	if license == nil {
+		d.SetId("")
+		return nil
+	}
+
+	return setLicenseToResourceData(license, d)
+}
+
+func resourceLicenseDelete(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.License.Delete(); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,69 @@
+package other_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+// TestAccResourceLicense removes the license of the nexus on destroy, which
+// breaks all PRO tests running in parallel. It therefore only runs if the
+// license file is passed explicitly with NEXUS_LICENSE_FILE.
+func TestAccResourceLicense(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+	licenseFile := os.Getenv("NEXUS_LICENSE_FILE")
+	if licenseFile == "" {
+		t.Skip("Skipping Nexus license tests, NEXUS_LICENSE_FILE is not set")
+	}
+
+	resName := "nexus_license.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLicenseConfig(licenseFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "license"),
+					resource.TestCheckResourceAttrSet(resName, "licensee"),
+					resource.TestCheckResourceAttrSet(resName, "expiration_date"),
+					resource.TestCheckResourceAttrSet(resName, "fingerprint"),
+					resource.TestCheckResourceAttrSet(resName, "features.#"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateId:           "license",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"license_base64"},
+			},
+			// An imported license does not know the license file, the same
+			// file must not install the license again
+			{
+				ResourceName:       resName,
+				ImportState:        true,
+				ImportStateId:      "license",
+				ImportStatePersist: true,
+			},
+			{
+				Config:   testAccResourceLicenseConfig(licenseFile),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceLicenseConfig(licenseFile string) string {
+	return fmt.Sprintf(`
+resource "nexus_license" "acceptance" {
+	license_base64 = filebase64("%s")
+}`, licenseFile)
+}
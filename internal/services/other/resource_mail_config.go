@@ -0,0 +1,162 @@
+package other
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceMailConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to configure the email server of the nexus repository manager.
+
+!> This resource can only be used **once** for a nexus`,
+
+		Create: resourceMailConfigUpdate,
+		Read:   resourceMailConfigRead,
+		Update: resourceMailConfigUpdate,
+		Delete: resourceMailConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"enabled": {
+				Description: "Whether sending emails is enabled, defaults to `true` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"host": {
+				Description: "The host of the SMTP server",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"port": {
+				Description:  "The port of the SMTP server",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"username": {
+				Description: "The username used to authenticate against the SMTP server",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description: "The password used to authenticate against the SMTP server",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"from_address": {
+				Description: "The address used as sender of all emails",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"subject_prefix": {
+				Description: "A prefix added to the subject of all emails",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"start_tls_enabled": {
+				Description: "Enable STARTTLS support for insecure connections, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"start_tls_required": {
+				Description: "Require STARTTLS support, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"ssl_on_connect_enabled": {
+				Description: "Enable SSL/TLS encryption upon connection, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"ssl_server_identity_check_enabled": {
+				Description: "Verify the server certificate when using TLS or SSL, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"nexus_trust_store_enabled": {
+				Description: "Use certificates stored in the Nexus truststore to connect to the SMTP server, defaults to `false` if unset",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func getMailConfigFromResourceData(d *schema.ResourceData) api.MailConfig {
+	return api.MailConfig{
+		Enabled:                       d.Get("enabled").(bool),
+		Host:                          d.Get("host").(string),
+		Port:                          d.Get("port").(int),
+		Username:                      d.Get("username").(string),
+		Password:                      d.Get("password").(string),
+		FromAddress:                   d.Get("from_address").(string),
+		SubjectPrefix:                 d.Get("subject_prefix").(string),
+		StartTLSEnabled:               d.Get("start_tls_enabled").(bool),
+		StartTLSRequired:              d.Get("start_tls_required").(bool),
+		SSLOnConnectEnabled:           d.Get("ssl_on_connect_enabled").(bool),
+		SSLServerIdentityCheckEnabled: d.Get("ssl_server_identity_check_enabled").(bool),
+		NexusTrustStoreEnabled:        d.Get("nexus_trust_store_enabled").(bool),
+	}
+}
+
+func setMailConfigToResourceData(config *api.MailConfig, d *schema.ResourceData) error {
+	d.SetId("mail")
+	d.Set("enabled", config.Enabled)
+	d.Set("host", config.Host)
+	d.Set("port", config.Port)
+	d.Set("username", config.Username)
+	d.Set("from_address", config.FromAddress)
+	d.Set("subject_prefix", config.SubjectPrefix)
+	d.Set("start_tls_enabled", config.StartTLSEnabled)
+	d.Set("start_tls_required", config.StartTLSRequired)
+	d.Set("ssl_on_connect_enabled", config.SSLOnConnectEnabled)
+	d.Set("ssl_server_identity_check_enabled", config.SSLServerIdentityCheckEnabled)
+	d.Set("nexus_trust_store_enabled", config.NexusTrustStoreEnabled)
+	return nil
+}
+
+func resourceMailConfigRead(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	config, err := client.MailConfig.Get()
+	if err != nil {
+		return err
+	}
+
+	return setMailConfigToResourceData(config, d)
+}
+
+func resourceMailConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	config := getMailConfigFromResourceData(d)
+	if err := client.MailConfig.Update(&config); err != nil {
+		return err
+	}
+
+	return resourceMailConfigRead(d, m)
+}
+
+func resourceMailConfigDelete(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.MailConfig.Delete(); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
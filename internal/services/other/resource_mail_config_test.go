@@ -0,0 +1,62 @@
+package other_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccResourceMailConfig(t *testing.T) {
+	resName := "nexus_mail_config.acceptance"
+
+	config := api.MailConfig{
+		Enabled:       true,
+		Host:          tools.GetEnv("SMTP_HOST", "mailhog"),
+		Port:          1025,
+		FromAddress:   "nexus@example.com",
+		SubjectPrefix: "[acceptance]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMailConfigConfig(config),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "mail"),
+					resource.TestCheckResourceAttr(resName, "enabled", strconv.FormatBool(config.Enabled)),
+					resource.TestCheckResourceAttr(resName, "host", config.Host),
+					resource.TestCheckResourceAttr(resName, "port", strconv.Itoa(config.Port)),
+					resource.TestCheckResourceAttr(resName, "from_address", config.FromAddress),
+					resource.TestCheckResourceAttr(resName, "subject_prefix", config.SubjectPrefix),
+					resource.TestCheckResourceAttr(resName, "start_tls_enabled", "false"),
+					resource.TestCheckResourceAttr(resName, "ssl_on_connect_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     "mail",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMailConfigConfig(config api.MailConfig) string {
+	return fmt.Sprintf(`
+resource "nexus_mail_config" "acceptance" {
+	enabled        = %t
+	host           = "%s"
+	port           = %d
+	from_address   = "%s"
+	subject_prefix = "%s"
+}
+`, config.Enabled, config.Host, config.Port, config.FromAddress, config.SubjectPrefix)
+}
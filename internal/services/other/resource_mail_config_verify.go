@@ -0,0 +1,71 @@
+package other
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceMailConfigVerify() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to send a test email with the current email server configuration of the nexus repository manager.
+
+The email is sent on creation and whenever ` + "`recipient`" + ` or ` + "`triggers`" + ` change. Destroying the resource does not change anything in nexus.`,
+
+		Create: resourceMailConfigVerifyCreate,
+		Read:   resourceMailConfigVerifyRead,
+		Delete: resourceMailConfigVerifyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"recipient": {
+				Description: "The email address the test email is sent to",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"triggers": {
+				Description: "Arbitrary values which cause a new test email to be sent when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeMap,
+			},
+			"success": {
+				Description: "Whether the test email was sent successfully",
+				Computed:    true,
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func resourceMailConfigVerifyCreate(d *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+	recipient := d.Get("recipient").(string)
+
+	verification, err := client.MailConfig.Verify(recipient)
+	if err != nil {
+		return err
+	}
+	if !verification.Success {
+		return fmt.Errorf("could not send test email to \"%s\": %s", recipient, verification.Reason)
+	}
+
+	d.SetId(recipient)
+	d.Set("success", verification.Success)
+	return nil
+}
+
+func resourceMailConfigVerifyRead(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func resourceMailConfigVerifyDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,50 @@
+package other_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccResourceMailConfigVerify(t *testing.T) {
+	resName := "nexus_mail_config_verify.acceptance"
+	recipient := "acceptance@example.com"
+
+	config := api.MailConfig{
+		Enabled:     true,
+		Host:        tools.GetEnv("SMTP_HOST", "mailhog"),
+		Port:        1025,
+		FromAddress: "nexus@example.com",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMailConfigConfig(config) + testAccResourceMailConfigVerifyConfig(recipient),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", recipient),
+					resource.TestCheckResourceAttr(resName, "recipient", recipient),
+					resource.TestCheckResourceAttr(resName, "success", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceMailConfigVerifyConfig(recipient string) string {
+	return fmt.Sprintf(`
+resource "nexus_mail_config_verify" "acceptance" {
+	recipient = "%s"
+
+	triggers = {
+		host = nexus_mail_config.acceptance.host
+	}
+}
+`, recipient)
+}
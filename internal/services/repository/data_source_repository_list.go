@@ -2,7 +2,7 @@ package repository
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
 )
 
@@ -47,10 +47,8 @@ func DataSourceRepositoryList() *schema.Resource {
 }
 
 func dataSourceRepositoryList(dataSource *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
-
 	items := []map[string]string{}
-	repositories, err := client.Repository.List()
+	repositories, err := api.FromMeta(m).Repository.List()
 	if err != nil {
 		return err
 	}
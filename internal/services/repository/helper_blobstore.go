@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// repositoryTimeouts limits how long an update waits for the repository to be
+// moved to another blobstore
+var repositoryTimeouts = &schema.ResourceTimeout{
+	Update: schema.DefaultTimeout(60 * time.Minute),
+}
+
+// getBlobstoreChange returns the old and new blobstore of an existing
+// repository, ok is false if the blobstore did not change
+func getBlobstoreChange(oldValue interface{}, newValue interface{}) (string, string, bool) {
+	oldName, newName := oldValue.(string), newValue.(string)
+	// blobstore names are case insensitive in nexus
+	return oldName, newName, oldName != "" && !strings.EqualFold(oldName, newName)
+}
+
+// resourceRepositoryCustomizeDiff fails the plan if the blobstore of an
+// existing repository changes but nexus can not move repositories between
+// blobstores, which requires a nexus pro license. Only the license is read,
+// the check is skipped if it can not be read, e.g. without the privilege.
+func resourceRepositoryCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown("storage.0.blob_store_name") {
+		return nil
+	}
+	oldName, newName, changed := getBlobstoreChange(diff.GetChange("storage.0.blob_store_name"))
+	if !changed {
+		return nil
+	}
+
+	licensed, err := api.FromMeta(m).License.IsInstalled()
+	if err != nil {
+		log.Printf("[WARN] Skipping check whether repository %s can be moved to blobstore %s: %v", diff.Id(), newName, err)
+		return nil
+	}
+	if !licensed {
+		return fmt.Errorf("the blob store of repository %s can not be changed from %s to %s because moving repositories requires Nexus Pro, the repository has to be replaced instead, e.g. with terraform apply -replace, which deletes its content", diff.Id(), oldName, newName)
+	}
+	return nil
+}
+
+// updateRepositoryContext returns the update function of a repository
+// resource. nexus ignores or rejects changes of the blobstore in updates, so
+// the repository is moved to the configured blobstore before the update.
+func updateRepositoryContext(update schema.UpdateFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, m interface{}) diag.Diagnostics {
+		oldName, newName, changed := getBlobstoreChange(resourceData.GetChange("storage.0.blob_store_name"))
+		if changed {
+			if err := moveRepositoryBlobstore(ctx, m, resourceData.Id(), oldName, newName, resourceData.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+			// record the move in the state even if the update fails
+			if err := resourceData.Set("storage", resourceData.Get("storage")); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return diag.FromErr(update(resourceData, m))
+	}
+}
+
+func moveRepositoryBlobstore(ctx context.Context, m interface{}, name string, oldName string, newName string, timeout time.Duration) error {
+	log.Printf("[INFO] Moving repository %s from blobstore %s to %s", name, oldName, newName)
+	return api.FromMeta(m).Repository.MoveToBlobstore(ctx, name, newName, timeout, func(task *api.Task) {
+		log.Printf("[INFO] Moving repository %s to blobstore %s: %s %s", name, newName, task.CurrentState, task.Message)
+	})
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlobstoreChange(t *testing.T) {
+	oldName, newName, changed := getBlobstoreChange("default", "fast")
+	assert.True(t, changed)
+	assert.Equal(t, "default", oldName)
+	assert.Equal(t, "fast", newName)
+
+	_, _, changed = getBlobstoreChange("default", "default")
+	assert.False(t, changed)
+
+	// nexus does not distinguish the case of blobstore names
+	_, _, changed = getBlobstoreChange("Default", "default")
+	assert.False(t, changed)
+
+	// the repository is being created
+	_, _, changed = getBlobstoreChange("", "default")
+	assert.False(t, changed)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+)
+
+// getRepository returns the repository with the given name, format and type
+// or nil if it does not exist. With enabled list cache it is read from the
+// settings of all repositories, which all repositories of a plan share,
+// otherwise get requests it on its own.
+func getRepository[T any](m interface{}, format string, repoType string, name string, get func(string) (*T, error)) (*T, error) {
+	client := api.FromMeta(m)
+	if !client.ListCacheEnabled() {
+		return get(name)
+	}
+
+	var repo T
+	found, err := client.Repository.GetSettings(name, format, repoType, &repo)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &repo, nil
+}
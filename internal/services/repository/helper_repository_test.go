@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRepository(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/service/rest/v1/repositorySettings":
+			w.Write([]byte(`[{"name":"raw","format":"raw","type":"hosted","online":true}]`))
+		case "/service/rest/v1/repositories/raw/hosted/raw":
+			w.Write([]byte(`{"name":"raw","format":"raw","type":"hosted","online":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	config := client.Config{URL: server.URL}
+	nexusClient := nexus.NewClient(config)
+	apiClient := api.NewClient(config)
+	api.Register(nexusClient, apiClient)
+
+	// without list cache every repository is requested on its own
+	repo, err := getRepository(nexusClient, "raw", "hosted", "raw", nexusClient.Repository.Raw.Hosted.Get)
+	assert.NoError(t, err)
+	assert.Equal(t, "raw", repo.Name)
+	assert.Equal(t, []string{"/service/rest/v1/repositories/raw/hosted/raw"}, paths)
+
+	paths = nil
+	apiClient.EnableListCache()
+	for i := 0; i < 2; i++ {
+		repo, err = getRepository(nexusClient, "raw", "hosted", "raw", nexusClient.Repository.Raw.Hosted.Get)
+		assert.NoError(t, err)
+		assert.True(t, repo.Online)
+
+		missing, err := getRepository(nexusClient, "raw", "hosted", "missing", nexusClient.Repository.Raw.Hosted.Get)
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+	}
+	assert.Equal(t, []string{"/service/rest/v1/repositorySettings"}, paths)
+
+}
@@ -13,11 +13,13 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted apt repository.",
 
-		Create: resourceAptHostedRepositoryCreate,
-		Delete: resourceAptHostedRepositoryDelete,
-		Exists: resourceAptHostedRepositoryExists,
-		Read:   resourceAptHostedRepositoryRead,
-		Update: resourceAptHostedRepositoryUpdate,
+		Create:        resourceAptHostedRepositoryCreate,
+		Delete:        resourceAptHostedRepositoryDelete,
+		Exists:        resourceAptHostedRepositoryExists,
+		Read:          resourceAptHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceAptHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -155,7 +157,7 @@ func resourceAptHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourceAptHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Apt.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "apt", "hosted", resourceData.Id(), client.Repository.Apt.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -189,6 +191,6 @@ func resourceAptHostedRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourceAptHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Apt.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "apt", "hosted", resourceData.Id(), client.Repository.Apt.Hosted.Get)
 	return repo != nil, err
 }
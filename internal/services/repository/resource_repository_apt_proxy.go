@@ -13,11 +13,13 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted apt repository.",
 
-		Create: resourceAptProxyRepositoryCreate,
-		Delete: resourceAptProxyRepositoryDelete,
-		Exists: resourceAptProxyRepositoryExists,
-		Read:   resourceAptProxyRepositoryRead,
-		Update: resourceAptProxyRepositoryUpdate,
+		Create:        resourceAptProxyRepositoryCreate,
+		Delete:        resourceAptProxyRepositoryDelete,
+		Exists:        resourceAptProxyRepositoryExists,
+		Read:          resourceAptProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceAptProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -184,7 +186,7 @@ func resourceAptProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceAptProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Apt.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "apt", "proxy", resourceData.Id(), client.Repository.Apt.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -218,6 +220,6 @@ func resourceAptProxyRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceAptProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Apt.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "apt", "proxy", resourceData.Id(), client.Repository.Apt.Proxy.Get)
 	return repo != nil, err
 }
@@ -14,11 +14,13 @@ func ResourceRepositoryBowerGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group bower repository.",
 
-		Create: resourceBowerGroupRepositoryCreate,
-		Delete: resourceBowerGroupRepositoryDelete,
-		Exists: resourceBowerGroupRepositoryExists,
-		Read:   resourceBowerGroupRepositoryRead,
-		Update: resourceBowerGroupRepositoryUpdate,
+		Create:        resourceBowerGroupRepositoryCreate,
+		Delete:        resourceBowerGroupRepositoryDelete,
+		Exists:        resourceBowerGroupRepositoryExists,
+		Read:          resourceBowerGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceBowerGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceBowerGroupRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceBowerGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Bower.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "bower", "group", resourceData.Id(), client.Repository.Bower.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceBowerGroupRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceBowerGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Bower.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "bower", "group", resourceData.Id(), client.Repository.Bower.Group.Get)
 	return repo != nil, err
 }
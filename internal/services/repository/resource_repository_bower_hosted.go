@@ -13,11 +13,13 @@ func ResourceRepositoryBowerHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Bower repository.",
 
-		Create: resourceBowerHostedRepositoryCreate,
-		Delete: resourceBowerHostedRepositoryDelete,
-		Exists: resourceBowerHostedRepositoryExists,
-		Read:   resourceBowerHostedRepositoryRead,
-		Update: resourceBowerHostedRepositoryUpdate,
+		Create:        resourceBowerHostedRepositoryCreate,
+		Delete:        resourceBowerHostedRepositoryDelete,
+		Exists:        resourceBowerHostedRepositoryExists,
+		Read:          resourceBowerHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceBowerHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceBowerHostedRepositoryCreate(resourceData *schema.ResourceData, m in
 func resourceBowerHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Bower.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "bower", "hosted", resourceData.Id(), client.Repository.Bower.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceBowerHostedRepositoryDelete(resourceData *schema.ResourceData, m in
 func resourceBowerHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Bower.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "bower", "hosted", resourceData.Id(), client.Repository.Bower.Hosted.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryBowerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an bower proxy repository.",
 
-		Create: resourceBowerProxyRepositoryCreate,
-		Delete: resourceBowerProxyRepositoryDelete,
-		Exists: resourceBowerProxyRepositoryExists,
-		Read:   resourceBowerProxyRepositoryRead,
-		Update: resourceBowerProxyRepositoryUpdate,
+		Create:        resourceBowerProxyRepositoryCreate,
+		Delete:        resourceBowerProxyRepositoryDelete,
+		Exists:        resourceBowerProxyRepositoryExists,
+		Read:          resourceBowerProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceBowerProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -178,7 +180,7 @@ func resourceBowerProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceBowerProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Bower.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "bower", "proxy", resourceData.Id(), client.Repository.Bower.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -212,6 +214,6 @@ func resourceBowerProxyRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceBowerProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Bower.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "bower", "proxy", resourceData.Id(), client.Repository.Bower.Proxy.Get)
 	return repo != nil, err
 }
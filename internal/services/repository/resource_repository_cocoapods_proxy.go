@@ -13,11 +13,13 @@ func ResourceRepositoryCocoapodsProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an cocoapods proxy repository.",
 
-		Create: resourceCocoapodsProxyRepositoryCreate,
-		Delete: resourceCocoapodsProxyRepositoryDelete,
-		Exists: resourceCocoapodsProxyRepositoryExists,
-		Read:   resourceCocoapodsProxyRepositoryRead,
-		Update: resourceCocoapodsProxyRepositoryUpdate,
+		Create:        resourceCocoapodsProxyRepositoryCreate,
+		Delete:        resourceCocoapodsProxyRepositoryDelete,
+		Exists:        resourceCocoapodsProxyRepositoryExists,
+		Read:          resourceCocoapodsProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceCocoapodsProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceCocoapodsProxyRepositoryCreate(resourceData *schema.ResourceData, m
 func resourceCocoapodsProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Cocoapods.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "cocoapods", "proxy", resourceData.Id(), client.Repository.Cocoapods.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceCocoapodsProxyRepositoryDelete(resourceData *schema.ResourceData, m
 func resourceCocoapodsProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Cocoapods.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "cocoapods", "proxy", resourceData.Id(), client.Repository.Cocoapods.Proxy.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryConanProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an conan proxy repository.",
 
-		Create: resourceConanProxyRepositoryCreate,
-		Delete: resourceConanProxyRepositoryDelete,
-		Exists: resourceConanProxyRepositoryExists,
-		Read:   resourceConanProxyRepositoryRead,
-		Update: resourceConanProxyRepositoryUpdate,
+		Create:        resourceConanProxyRepositoryCreate,
+		Delete:        resourceConanProxyRepositoryDelete,
+		Exists:        resourceConanProxyRepositoryExists,
+		Read:          resourceConanProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceConanProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceConanProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceConanProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Conan.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "conan", "proxy", resourceData.Id(), client.Repository.Conan.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceConanProxyRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceConanProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Conan.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "conan", "proxy", resourceData.Id(), client.Repository.Conan.Proxy.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryCondaProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an conda proxy repository.",
 
-		Create: resourceCondaProxyRepositoryCreate,
-		Delete: resourceCondaProxyRepositoryDelete,
-		Exists: resourceCondaProxyRepositoryExists,
-		Read:   resourceCondaProxyRepositoryRead,
-		Update: resourceCondaProxyRepositoryUpdate,
+		Create:        resourceCondaProxyRepositoryCreate,
+		Delete:        resourceCondaProxyRepositoryDelete,
+		Exists:        resourceCondaProxyRepositoryExists,
+		Read:          resourceCondaProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceCondaProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceCondaProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceCondaProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Conda.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "conda", "proxy", resourceData.Id(), client.Repository.Conda.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceCondaProxyRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceCondaProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Conda.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "conda", "proxy", resourceData.Id(), client.Repository.Conda.Proxy.Get)
 	return repo != nil, err
 }
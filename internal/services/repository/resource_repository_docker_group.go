@@ -15,11 +15,13 @@ func ResourceRepositoryDockerGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group docker repository.",
 
-		Create: resourceDockerGroupRepositoryCreate,
-		Delete: resourceDockerGroupRepositoryDelete,
-		Exists: resourceDockerGroupRepositoryExists,
-		Read:   resourceDockerGroupRepositoryRead,
-		Update: resourceDockerGroupRepositoryUpdate,
+		Create:        resourceDockerGroupRepositoryCreate,
+		Delete:        resourceDockerGroupRepositoryDelete,
+		Exists:        resourceDockerGroupRepositoryExists,
+		Read:          resourceDockerGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceDockerGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -118,7 +120,7 @@ func resourceDockerGroupRepositoryCreate(resourceData *schema.ResourceData, m in
 func resourceDockerGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Docker.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "docker", "group", resourceData.Id(), client.Repository.Docker.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -159,6 +161,6 @@ func resourceDockerGroupRepositoryDelete(resourceData *schema.ResourceData, m in
 func resourceDockerGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Docker.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "docker", "group", resourceData.Id(), client.Repository.Docker.Group.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryDockerHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted docker repository.",
 
-		Create: resourceDockerHostedRepositoryCreate,
-		Delete: resourceDockerHostedRepositoryDelete,
-		Exists: resourceDockerHostedRepositoryExists,
-		Read:   resourceDockerHostedRepositoryRead,
-		Update: resourceDockerHostedRepositoryUpdate,
+		Create:        resourceDockerHostedRepositoryCreate,
+		Delete:        resourceDockerHostedRepositoryDelete,
+		Exists:        resourceDockerHostedRepositoryExists,
+		Read:          resourceDockerHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceDockerHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -138,7 +140,7 @@ func resourceDockerHostedRepositoryCreate(resourceData *schema.ResourceData, m i
 func resourceDockerHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Docker.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "docker", "hosted", resourceData.Id(), client.Repository.Docker.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -172,6 +174,6 @@ func resourceDockerHostedRepositoryDelete(resourceData *schema.ResourceData, m i
 func resourceDockerHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Docker.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "docker", "hosted", resourceData.Id(), client.Repository.Docker.Hosted.Get)
 	return repo != nil, err
 }
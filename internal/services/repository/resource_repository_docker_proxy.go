@@ -17,11 +17,13 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a docker proxy repository.",
 
-		Create: resourceDockerProxyRepositoryCreate,
-		Delete: resourceDockerProxyRepositoryDelete,
-		Exists: resourceDockerProxyRepositoryExists,
-		Read:   resourceDockerProxyRepositoryRead,
-		Update: resourceDockerProxyRepositoryUpdate,
+		Create:        resourceDockerProxyRepositoryCreate,
+		Delete:        resourceDockerProxyRepositoryDelete,
+		Exists:        resourceDockerProxyRepositoryExists,
+		Read:          resourceDockerProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceDockerProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -228,7 +230,7 @@ func resourceDockerProxyRepositoryCreate(resourceData *schema.ResourceData, m in
 func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "docker", "proxy", resourceData.Id(), client.Repository.Docker.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -262,6 +264,6 @@ func resourceDockerProxyRepositoryDelete(resourceData *schema.ResourceData, m in
 func resourceDockerProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "docker", "proxy", resourceData.Id(), client.Repository.Docker.Proxy.Get)
 	return repo != nil, err
 }
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+const (
+	capabilityTypeFirewallAudit = "firewall.audit"
+)
+
+func ResourceRepositoryFirewall() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to configure Sonatype Firewall audit and quarantine for a proxy repository.
+
+Requires a connection to an IQ server, see ` + "`nexus_iq_server`" + `.
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create:        resourceRepositoryFirewallCreate,
+		Read:          resourceRepositoryFirewallRead,
+		Update:        resourceRepositoryFirewallUpdate,
+		Delete:        resourceRepositoryFirewallDelete,
+		CustomizeDiff: resourceRepositoryFirewallCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the proxy repository",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"audit": {
+				Description: "Whether components downloaded through the repository are evaluated by the IQ server, defaults to `true` if unset",
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"quarantine": {
+				Description: "Whether components violating a policy are quarantined, requires `audit` to be enabled, defaults to `false` if unset",
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+		},
+	}
+}
+
+func getRepositoryFirewallCapability(client *api.Client, repository string) (*api.Capability, error) {
+	capabilities, err := client.Capability.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, capability := range capabilities {
+		if capability.TypeID == capabilityTypeFirewallAudit && capability.Properties["repository"] == repository {
+			return &capability, nil
+		}
+	}
+	return nil, nil
+}
+
+func getRepositoryFirewallFromResourceData(resourceData *schema.ResourceData) api.Capability {
+	return api.Capability{
+		TypeID:  capabilityTypeFirewallAudit,
+		Enabled: resourceData.Get("audit").(bool),
+		Properties: map[string]string{
+			"repository": resourceData.Get("repository").(string),
+			"quarantine": strconv.FormatBool(resourceData.Get("quarantine").(bool)),
+		},
+	}
+}
+
+func resourceRepositoryFirewallCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	capability := getRepositoryFirewallFromResourceData(resourceData)
+	if _, err := client.Capability.Create(&capability); err != nil {
+		return err
+	}
+
+	resourceData.SetId(resourceData.Get("repository").(string))
+	return resourceRepositoryFirewallRead(resourceData, m)
+}
+
+func resourceRepositoryFirewallRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	capability, err := getRepositoryFirewallCapability(client, resourceData.Id())
+	if errors.Is(err, api.ErrScriptNotStored) {
+		log.Printf("[WARN] Could not refresh firewall configuration of repository %s: %v", resourceData.Id(), err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if capability == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	quarantine, err := strconv.ParseBool(capability.Properties["quarantine"])
+	if err != nil {
+		quarantine = false
+	}
+
+	resourceData.Set("repository", capability.Properties["repository"])
+	resourceData.Set("audit", capability.Enabled)
+	resourceData.Set("quarantine", quarantine)
+	return nil
+}
+
+func resourceRepositoryFirewallUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.Capability.StoreScript(); err != nil {
+		return err
+	}
+	existing, err := getRepositoryFirewallCapability(client, resourceData.Id())
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("firewall configuration of repository %s does not exist", resourceData.Id())
+	}
+
+	capability := getRepositoryFirewallFromResourceData(resourceData)
+	capability.ID = existing.ID
+	if _, err := client.Capability.Update(&capability); err != nil {
+		return err
+	}
+
+	return resourceRepositoryFirewallRead(resourceData, m)
+}
+
+func resourceRepositoryFirewallDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.Capability.StoreScript(); err != nil {
+		return err
+	}
+	capability, err := getRepositoryFirewallCapability(client, resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	if capability != nil {
+		if err := client.Capability.Delete(capability.ID); err != nil {
+			return err
+		}
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+// resourceRepositoryFirewallCustomizeDiff ensures quarantine is only enabled
+// with audit and the repository is a proxy repository. Repositories which do
+// not exist yet, e.g. because they are created in the same apply, are checked
+// by nexus on creation.
+func resourceRepositoryFirewallCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.NewValueKnown("quarantine") && diff.NewValueKnown("audit") && diff.Get("quarantine").(bool) && !diff.Get("audit").(bool) {
+		return fmt.Errorf("quarantine requires audit to be enabled")
+	}
+	if !diff.NewValueKnown("repository") {
+		return nil
+	}
+
+	name := diff.Get("repository").(string)
+	repositories, err := api.FromMeta(m).Repository.List()
+	if err != nil {
+		return err
+	}
+	for _, repository := range repositories {
+		if repository.Name == name && repository.Type != "proxy" {
+			return fmt.Errorf("repository %s is a %s repository, firewall can only be configured for proxy repositories", name, repository.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+)
+
+func TestAccResourceRepositoryFirewall(t *testing.T) {
+	resName := "nexus_repository_firewall.acceptance"
+	repoName := fmt.Sprintf("acceptance-firewall-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryFirewallConfig(repoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", repoName),
+					resource.TestCheckResourceAttr(resName, "repository", repoName),
+					resource.TestCheckResourceAttr(resName, "audit", "true"),
+					resource.TestCheckResourceAttr(resName, "quarantine", "false"),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryFirewallConfig(repoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "quarantine", "true"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateId:     repoName,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceRepositoryFirewallConfig(repoName, true) + testAccResourceRepositoryFirewallHostedConfig(repoName),
+			},
+			{
+				Config:      testAccResourceRepositoryFirewallConfig(repoName, true) + testAccResourceRepositoryFirewallHostedConfig(repoName) + testAccResourceRepositoryFirewallHostedFirewallConfig(repoName),
+				ExpectError: regexp.MustCompile("firewall can only be configured for proxy repositories"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryFirewallQuarantineWithoutAudit(t *testing.T) {
+	repoName := fmt.Sprintf("acceptance-firewall-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the repository is unknown during plan, as it is created in the same apply
+				Config:      testAccResourceRepositoryFirewallConfig(repoName, true) + testAccResourceRepositoryFirewallWithoutAuditConfig(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("quarantine requires audit to be enabled"),
+			},
+		},
+	})
+}
+
+func testAccResourceRepositoryFirewallConfig(repoName string, quarantine bool) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_proxy" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+	}
+
+	proxy {
+		remote_url = "https://example.com"
+	}
+
+	http_client {
+		blocked    = false
+		auto_block = true
+	}
+}
+
+resource "nexus_repository_firewall" "acceptance" {
+	repository = nexus_repository_raw_proxy.acceptance.name
+	quarantine = %t
+}
+`, repoName, quarantine)
+}
+
+func testAccResourceRepositoryFirewallHostedConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name = "%s-hosted"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+`, repoName)
+}
+
+// The hosted repository is created in a previous step, as the repository type
+// can only be checked during plan for existing repositories
+func testAccResourceRepositoryFirewallHostedFirewallConfig(repoName string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_firewall" "hosted" {
+	repository = "%s-hosted"
+}
+`, repoName)
+}
+
+func testAccResourceRepositoryFirewallWithoutAuditConfig() string {
+	return `
+resource "nexus_repository_firewall" "without_audit" {
+	repository = nexus_repository_raw_proxy.acceptance.name
+	audit      = false
+	quarantine = true
+}
+`
+}
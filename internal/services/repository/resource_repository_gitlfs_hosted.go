@@ -13,11 +13,13 @@ func ResourceRepositoryGitlfsHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted gitlfs repository.",
 
-		Create: resourceGitlfsHostedRepositoryCreate,
-		Delete: resourceGitlfsHostedRepositoryDelete,
-		Exists: resourceGitlfsHostedRepositoryExists,
-		Read:   resourceGitlfsHostedRepositoryRead,
-		Update: resourceGitlfsHostedRepositoryUpdate,
+		Create:        resourceGitlfsHostedRepositoryCreate,
+		Delete:        resourceGitlfsHostedRepositoryDelete,
+		Exists:        resourceGitlfsHostedRepositoryExists,
+		Read:          resourceGitlfsHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceGitlfsHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceGitlfsHostedRepositoryCreate(resourceData *schema.ResourceData, m i
 func resourceGitlfsHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.GitLfs.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "gitlfs", "hosted", resourceData.Id(), client.Repository.GitLfs.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceGitlfsHostedRepositoryDelete(resourceData *schema.ResourceData, m i
 func resourceGitlfsHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.GitLfs.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "gitlfs", "hosted", resourceData.Id(), client.Repository.GitLfs.Hosted.Get)
 	return repo != nil, err
 }
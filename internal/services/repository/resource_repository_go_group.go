@@ -14,11 +14,13 @@ func ResourceRepositoryGoGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group go repository.",
 
-		Create: resourceGoGroupRepositoryCreate,
-		Delete: resourceGoGroupRepositoryDelete,
-		Exists: resourceGoGroupRepositoryExists,
-		Read:   resourceGoGroupRepositoryRead,
-		Update: resourceGoGroupRepositoryUpdate,
+		Create:        resourceGoGroupRepositoryCreate,
+		Delete:        resourceGoGroupRepositoryDelete,
+		Exists:        resourceGoGroupRepositoryExists,
+		Read:          resourceGoGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceGoGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceGoGroupRepositoryCreate(resourceData *schema.ResourceData, m interf
 func resourceGoGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Go.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "go", "group", resourceData.Id(), client.Repository.Go.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceGoGroupRepositoryDelete(resourceData *schema.ResourceData, m interf
 func resourceGoGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Go.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "go", "group", resourceData.Id(), client.Repository.Go.Group.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryGoProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a go proxy repository.",
 
-		Create: resourceGoProxyRepositoryCreate,
-		Delete: resourceGoProxyRepositoryDelete,
-		Exists: resourceGoProxyRepositoryExists,
-		Read:   resourceGoProxyRepositoryRead,
-		Update: resourceGoProxyRepositoryUpdate,
+		Create:        resourceGoProxyRepositoryCreate,
+		Delete:        resourceGoProxyRepositoryDelete,
+		Exists:        resourceGoProxyRepositoryExists,
+		Read:          resourceGoProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceGoProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceGoProxyRepositoryCreate(resourceData *schema.ResourceData, m interf
 func resourceGoProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Go.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "go", "proxy", resourceData.Id(), client.Repository.Go.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceGoProxyRepositoryDelete(resourceData *schema.ResourceData, m interf
 func resourceGoProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Go.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "go", "proxy", resourceData.Id(), client.Repository.Go.Proxy.Get)
 	return repo != nil, err
 }
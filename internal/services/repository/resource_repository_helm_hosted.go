@@ -13,11 +13,13 @@ func ResourceRepositoryHelmHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted helm repository.",
 
-		Create: resourceHelmHostedRepositoryCreate,
-		Delete: resourceHelmHostedRepositoryDelete,
-		Exists: resourceHelmHostedRepositoryExists,
-		Read:   resourceHelmHostedRepositoryRead,
-		Update: resourceHelmHostedRepositoryUpdate,
+		Create:        resourceHelmHostedRepositoryCreate,
+		Delete:        resourceHelmHostedRepositoryDelete,
+		Exists:        resourceHelmHostedRepositoryExists,
+		Read:          resourceHelmHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceHelmHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceHelmHostedRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceHelmHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Helm.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "helm", "hosted", resourceData.Id(), client.Repository.Helm.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceHelmHostedRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceHelmHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Helm.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "helm", "hosted", resourceData.Id(), client.Repository.Helm.Hosted.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryHelmProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a helm proxy repository.",
 
-		Create: resourceHelmProxyRepositoryCreate,
-		Delete: resourceHelmProxyRepositoryDelete,
-		Exists: resourceHelmProxyRepositoryExists,
-		Read:   resourceHelmProxyRepositoryRead,
-		Update: resourceHelmProxyRepositoryUpdate,
+		Create:        resourceHelmProxyRepositoryCreate,
+		Delete:        resourceHelmProxyRepositoryDelete,
+		Exists:        resourceHelmProxyRepositoryExists,
+		Read:          resourceHelmProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceHelmProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceHelmProxyRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourceHelmProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Helm.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "helm", "proxy", resourceData.Id(), client.Repository.Helm.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceHelmProxyRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourceHelmProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Helm.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "helm", "proxy", resourceData.Id(), client.Repository.Helm.Proxy.Get)
 	return repo != nil, err
 }
@@ -14,11 +14,13 @@ func ResourceRepositoryMavenGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group maven repository.",
 
-		Create: resourceMavenGroupRepositoryCreate,
-		Delete: resourceMavenGroupRepositoryDelete,
-		Exists: resourceMavenGroupRepositoryExists,
-		Read:   resourceMavenGroupRepositoryRead,
-		Update: resourceMavenGroupRepositoryUpdate,
+		Create:        resourceMavenGroupRepositoryCreate,
+		Delete:        resourceMavenGroupRepositoryDelete,
+		Exists:        resourceMavenGroupRepositoryExists,
+		Read:          resourceMavenGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceMavenGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceMavenGroupRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceMavenGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Maven.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "maven2", "group", resourceData.Id(), client.Repository.Maven.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceMavenGroupRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceMavenGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Maven.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "maven2", "group", resourceData.Id(), client.Repository.Maven.Group.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryMavenHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted maven repository.",
 
-		Create: resourceMavenHostedRepositoryCreate,
-		Delete: resourceMavenHostedRepositoryDelete,
-		Exists: resourceMavenHostedRepositoryExists,
-		Read:   resourceMavenHostedRepositoryRead,
-		Update: resourceMavenHostedRepositoryUpdate,
+		Create:        resourceMavenHostedRepositoryCreate,
+		Delete:        resourceMavenHostedRepositoryDelete,
+		Exists:        resourceMavenHostedRepositoryExists,
+		Read:          resourceMavenHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceMavenHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -131,7 +133,7 @@ func resourceMavenHostedRepositoryCreate(resourceData *schema.ResourceData, m in
 func resourceMavenHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Maven.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "maven2", "hosted", resourceData.Id(), client.Repository.Maven.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -165,6 +167,6 @@ func resourceMavenHostedRepositoryDelete(resourceData *schema.ResourceData, m in
 func resourceMavenHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Maven.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "maven2", "hosted", resourceData.Id(), client.Repository.Maven.Hosted.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryMavenProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a maven proxy repository.",
 
-		Create: resourceMavenProxyRepositoryCreate,
-		Delete: resourceMavenProxyRepositoryDelete,
-		Exists: resourceMavenProxyRepositoryExists,
-		Read:   resourceMavenProxyRepositoryRead,
-		Update: resourceMavenProxyRepositoryUpdate,
+		Create:        resourceMavenProxyRepositoryCreate,
+		Delete:        resourceMavenProxyRepositoryDelete,
+		Exists:        resourceMavenProxyRepositoryExists,
+		Read:          resourceMavenProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceMavenProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -188,7 +190,7 @@ func resourceMavenProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceMavenProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Maven.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "maven2", "proxy", resourceData.Id(), client.Repository.Maven.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -222,6 +224,6 @@ func resourceMavenProxyRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceMavenProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Maven.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "maven2", "proxy", resourceData.Id(), client.Repository.Maven.Proxy.Get)
 	return repo != nil, err
 }
@@ -15,11 +15,13 @@ func ResourceRepositoryNpmGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group npm repository.",
 
-		Create: resourceNpmGroupRepositoryCreate,
-		Delete: resourceNpmGroupRepositoryDelete,
-		Exists: resourceNpmGroupRepositoryExists,
-		Read:   resourceNpmGroupRepositoryRead,
-		Update: resourceNpmGroupRepositoryUpdate,
+		Create:        resourceNpmGroupRepositoryCreate,
+		Delete:        resourceNpmGroupRepositoryDelete,
+		Exists:        resourceNpmGroupRepositoryExists,
+		Read:          resourceNpmGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceNpmGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -96,7 +98,7 @@ func resourceNpmGroupRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceNpmGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Npm.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "npm", "group", resourceData.Id(), client.Repository.Npm.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -136,6 +138,6 @@ func resourceNpmGroupRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceNpmGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Npm.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "npm", "group", resourceData.Id(), client.Repository.Npm.Group.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryNpmHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Npm repository.",
 
-		Create: resourceNpmHostedRepositoryCreate,
-		Delete: resourceNpmHostedRepositoryDelete,
-		Exists: resourceNpmHostedRepositoryExists,
-		Read:   resourceNpmHostedRepositoryRead,
-		Update: resourceNpmHostedRepositoryUpdate,
+		Create:        resourceNpmHostedRepositoryCreate,
+		Delete:        resourceNpmHostedRepositoryDelete,
+		Exists:        resourceNpmHostedRepositoryExists,
+		Read:          resourceNpmHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceNpmHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceNpmHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourceNpmHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Npm.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "npm", "hosted", resourceData.Id(), client.Repository.Npm.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceNpmHostedRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourceNpmHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Npm.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "npm", "hosted", resourceData.Id(), client.Repository.Npm.Hosted.Get)
 	return repo != nil, err
 }
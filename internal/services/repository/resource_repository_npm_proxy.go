@@ -13,11 +13,13 @@ func ResourceRepositoryNpmProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an NPM proxy repository.",
 
-		Create: resourceNpmProxyRepositoryCreate,
-		Delete: resourceNpmProxyRepositoryDelete,
-		Exists: resourceNpmProxyRepositoryExists,
-		Read:   resourceNpmProxyRepositoryRead,
-		Update: resourceNpmProxyRepositoryUpdate,
+		Create:        resourceNpmProxyRepositoryCreate,
+		Delete:        resourceNpmProxyRepositoryDelete,
+		Exists:        resourceNpmProxyRepositoryExists,
+		Read:          resourceNpmProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceNpmProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -187,7 +189,7 @@ func resourceNpmProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceNpmProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Npm.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "npm", "proxy", resourceData.Id(), client.Repository.Npm.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -221,6 +223,6 @@ func resourceNpmProxyRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceNpmProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Npm.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "npm", "proxy", resourceData.Id(), client.Repository.Npm.Proxy.Get)
 	return repo != nil, err
 }
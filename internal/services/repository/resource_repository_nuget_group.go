@@ -14,11 +14,13 @@ func ResourceRepositoryNugetGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group nuget repository.",
 
-		Create: resourceNugetGroupRepositoryCreate,
-		Delete: resourceNugetGroupRepositoryDelete,
-		Exists: resourceNugetGroupRepositoryExists,
-		Read:   resourceNugetGroupRepositoryRead,
-		Update: resourceNugetGroupRepositoryUpdate,
+		Create:        resourceNugetGroupRepositoryCreate,
+		Delete:        resourceNugetGroupRepositoryDelete,
+		Exists:        resourceNugetGroupRepositoryExists,
+		Read:          resourceNugetGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceNugetGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceNugetGroupRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceNugetGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Nuget.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "nuget", "group", resourceData.Id(), client.Repository.Nuget.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceNugetGroupRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceNugetGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Nuget.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "nuget", "group", resourceData.Id(), client.Repository.Nuget.Group.Get)
 	return repo != nil, err
 }
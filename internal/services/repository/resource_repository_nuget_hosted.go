@@ -13,11 +13,13 @@ func ResourceRepositoryNugetHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Nuget repository.",
 
-		Create: resourceNugetHostedRepositoryCreate,
-		Delete: resourceNugetHostedRepositoryDelete,
-		Exists: resourceNugetHostedRepositoryExists,
-		Read:   resourceNugetHostedRepositoryRead,
-		Update: resourceNugetHostedRepositoryUpdate,
+		Create:        resourceNugetHostedRepositoryCreate,
+		Delete:        resourceNugetHostedRepositoryDelete,
+		Exists:        resourceNugetHostedRepositoryExists,
+		Read:          resourceNugetHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceNugetHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceNugetHostedRepositoryCreate(resourceData *schema.ResourceData, m in
 func resourceNugetHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Nuget.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "nuget", "hosted", resourceData.Id(), client.Repository.Nuget.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceNugetHostedRepositoryDelete(resourceData *schema.ResourceData, m in
 func resourceNugetHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Nuget.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "nuget", "hosted", resourceData.Id(), client.Repository.Nuget.Hosted.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryNugetProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an NPM proxy repository.",
 
-		Create: resourceNugetProxyRepositoryCreate,
-		Delete: resourceNugetProxyRepositoryDelete,
-		Exists: resourceNugetProxyRepositoryExists,
-		Read:   resourceNugetProxyRepositoryRead,
-		Update: resourceNugetProxyRepositoryUpdate,
+		Create:        resourceNugetProxyRepositoryCreate,
+		Delete:        resourceNugetProxyRepositoryDelete,
+		Exists:        resourceNugetProxyRepositoryExists,
+		Read:          resourceNugetProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceNugetProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -185,7 +187,7 @@ func resourceNugetProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourceNugetProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Nuget.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "nuget", "proxy", resourceData.Id(), client.Repository.Nuget.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -219,6 +221,6 @@ func resourceNugetProxyRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourceNugetProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Nuget.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "nuget", "proxy", resourceData.Id(), client.Repository.Nuget.Proxy.Get)
 	return repo != nil, err
 }
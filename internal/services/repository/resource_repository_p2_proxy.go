@@ -13,11 +13,13 @@ func ResourceRepositoryP2Proxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an p2 proxy repository.",
 
-		Create: resourceP2ProxyRepositoryCreate,
-		Delete: resourceP2ProxyRepositoryDelete,
-		Exists: resourceP2ProxyRepositoryExists,
-		Read:   resourceP2ProxyRepositoryRead,
-		Update: resourceP2ProxyRepositoryUpdate,
+		Create:        resourceP2ProxyRepositoryCreate,
+		Delete:        resourceP2ProxyRepositoryDelete,
+		Exists:        resourceP2ProxyRepositoryExists,
+		Read:          resourceP2ProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceP2ProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceP2ProxyRepositoryCreate(resourceData *schema.ResourceData, m interf
 func resourceP2ProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.P2.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "p2", "proxy", resourceData.Id(), client.Repository.P2.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceP2ProxyRepositoryDelete(resourceData *schema.ResourceData, m interf
 func resourceP2ProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.P2.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "p2", "proxy", resourceData.Id(), client.Repository.P2.Proxy.Get)
 	return repo != nil, err
 }
@@ -14,11 +14,13 @@ func ResourceRepositoryPypiGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group pypi repository.",
 
-		Create: resourcePypiGroupRepositoryCreate,
-		Delete: resourcePypiGroupRepositoryDelete,
-		Exists: resourcePypiGroupRepositoryExists,
-		Read:   resourcePypiGroupRepositoryRead,
-		Update: resourcePypiGroupRepositoryUpdate,
+		Create:        resourcePypiGroupRepositoryCreate,
+		Delete:        resourcePypiGroupRepositoryDelete,
+		Exists:        resourcePypiGroupRepositoryExists,
+		Read:          resourcePypiGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourcePypiGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourcePypiGroupRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourcePypiGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Pypi.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "pypi", "group", resourceData.Id(), client.Repository.Pypi.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourcePypiGroupRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourcePypiGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Pypi.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "pypi", "group", resourceData.Id(), client.Repository.Pypi.Group.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryPypiHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Pypi repository.",
 
-		Create: resourcePypiHostedRepositoryCreate,
-		Delete: resourcePypiHostedRepositoryDelete,
-		Exists: resourcePypiHostedRepositoryExists,
-		Read:   resourcePypiHostedRepositoryRead,
-		Update: resourcePypiHostedRepositoryUpdate,
+		Create:        resourcePypiHostedRepositoryCreate,
+		Delete:        resourcePypiHostedRepositoryDelete,
+		Exists:        resourcePypiHostedRepositoryExists,
+		Read:          resourcePypiHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourcePypiHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourcePypiHostedRepositoryCreate(resourceData *schema.ResourceData, m int
 func resourcePypiHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Pypi.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "pypi", "hosted", resourceData.Id(), client.Repository.Pypi.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourcePypiHostedRepositoryDelete(resourceData *schema.ResourceData, m int
 func resourcePypiHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Pypi.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "pypi", "hosted", resourceData.Id(), client.Repository.Pypi.Hosted.Get)
 	return repo != nil, err
 }
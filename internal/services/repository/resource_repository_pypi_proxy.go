@@ -13,11 +13,13 @@ func ResourceRepositoryPypiProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an NPM proxy repository.",
 
-		Create: resourcePypiProxyRepositoryCreate,
-		Delete: resourcePypiProxyRepositoryDelete,
-		Exists: resourcePypiProxyRepositoryExists,
-		Read:   resourcePypiProxyRepositoryRead,
-		Update: resourcePypiProxyRepositoryUpdate,
+		Create:        resourcePypiProxyRepositoryCreate,
+		Delete:        resourcePypiProxyRepositoryDelete,
+		Exists:        resourcePypiProxyRepositoryExists,
+		Read:          resourcePypiProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourcePypiProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourcePypiProxyRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourcePypiProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Pypi.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "pypi", "proxy", resourceData.Id(), client.Repository.Pypi.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourcePypiProxyRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourcePypiProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Pypi.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "pypi", "proxy", resourceData.Id(), client.Repository.Pypi.Proxy.Get)
 	return repo != nil, err
 }
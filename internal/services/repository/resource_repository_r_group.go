@@ -14,11 +14,13 @@ func ResourceRepositoryRGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group r repository.",
 
-		Create: resourceRGroupRepositoryCreate,
-		Delete: resourceRGroupRepositoryDelete,
-		Exists: resourceRGroupRepositoryExists,
-		Read:   resourceRGroupRepositoryRead,
-		Update: resourceRGroupRepositoryUpdate,
+		Create:        resourceRGroupRepositoryCreate,
+		Delete:        resourceRGroupRepositoryDelete,
+		Exists:        resourceRGroupRepositoryExists,
+		Read:          resourceRGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceRGroupRepositoryCreate(resourceData *schema.ResourceData, m interfa
 func resourceRGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.R.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "r", "group", resourceData.Id(), client.Repository.R.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceRGroupRepositoryDelete(resourceData *schema.ResourceData, m interfa
 func resourceRGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.R.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "r", "group", resourceData.Id(), client.Repository.R.Group.Get)
 	return repo != nil, err
 }
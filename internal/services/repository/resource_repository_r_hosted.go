@@ -13,11 +13,13 @@ func ResourceRepositoryRHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted R repository.",
 
-		Create: resourceRHostedRepositoryCreate,
-		Delete: resourceRHostedRepositoryDelete,
-		Exists: resourceRHostedRepositoryExists,
-		Read:   resourceRHostedRepositoryRead,
-		Update: resourceRHostedRepositoryUpdate,
+		Create:        resourceRHostedRepositoryCreate,
+		Delete:        resourceRHostedRepositoryDelete,
+		Exists:        resourceRHostedRepositoryExists,
+		Read:          resourceRHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceRHostedRepositoryCreate(resourceData *schema.ResourceData, m interf
 func resourceRHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.R.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "r", "hosted", resourceData.Id(), client.Repository.R.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceRHostedRepositoryDelete(resourceData *schema.ResourceData, m interf
 func resourceRHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.R.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "r", "hosted", resourceData.Id(), client.Repository.R.Hosted.Get)
 	return repo != nil, err
 }
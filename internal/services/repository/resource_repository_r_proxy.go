@@ -13,11 +13,13 @@ func ResourceRepositoryRProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an NPM proxy repository.",
 
-		Create: resourceRProxyRepositoryCreate,
-		Delete: resourceRProxyRepositoryDelete,
-		Exists: resourceRProxyRepositoryExists,
-		Read:   resourceRProxyRepositoryRead,
-		Update: resourceRProxyRepositoryUpdate,
+		Create:        resourceRProxyRepositoryCreate,
+		Delete:        resourceRProxyRepositoryDelete,
+		Exists:        resourceRProxyRepositoryExists,
+		Read:          resourceRProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -167,7 +169,7 @@ func resourceRProxyRepositoryCreate(resourceData *schema.ResourceData, m interfa
 func resourceRProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.R.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "r", "proxy", resourceData.Id(), client.Repository.R.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -201,6 +203,6 @@ func resourceRProxyRepositoryDelete(resourceData *schema.ResourceData, m interfa
 func resourceRProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.R.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "r", "proxy", resourceData.Id(), client.Repository.R.Proxy.Get)
 	return repo != nil, err
 }
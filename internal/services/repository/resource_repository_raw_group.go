@@ -14,11 +14,13 @@ func ResourceRepositoryRawGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group raw repository.",
 
-		Create: resourceRawGroupRepositoryCreate,
-		Delete: resourceRawGroupRepositoryDelete,
-		Exists: resourceRawGroupRepositoryExists,
-		Read:   resourceRawGroupRepositoryRead,
-		Update: resourceRawGroupRepositoryUpdate,
+		Create:        resourceRawGroupRepositoryCreate,
+		Delete:        resourceRawGroupRepositoryDelete,
+		Exists:        resourceRawGroupRepositoryExists,
+		Read:          resourceRawGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRawGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceRawGroupRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceRawGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Raw.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "raw", "group", resourceData.Id(), client.Repository.Raw.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceRawGroupRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceRawGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Raw.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "raw", "group", resourceData.Id(), client.Repository.Raw.Group.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryRawHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted raw repository.",
 
-		Create: resourceRawHostedRepositoryCreate,
-		Delete: resourceRawHostedRepositoryDelete,
-		Exists: resourceRawHostedRepositoryExists,
-		Read:   resourceRawHostedRepositoryRead,
-		Update: resourceRawHostedRepositoryUpdate,
+		Create:        resourceRawHostedRepositoryCreate,
+		Delete:        resourceRawHostedRepositoryDelete,
+		Exists:        resourceRawHostedRepositoryExists,
+		Read:          resourceRawHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRawHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceRawHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourceRawHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Raw.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "raw", "hosted", resourceData.Id(), client.Repository.Raw.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceRawHostedRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourceRawHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Raw.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "raw", "hosted", resourceData.Id(), client.Repository.Raw.Hosted.Get)
 	return repo != nil, err
 }
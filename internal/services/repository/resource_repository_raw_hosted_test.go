@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
 )
 
 func testAccResourceRepositoryRawHosted() repository.RawHostedRepository {
@@ -80,3 +81,41 @@ func TestAccResourceRepositoryRawHosted(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryRawHostedMoveBlobstore(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	repo := testAccResourceRepositoryRawHosted()
+	resourceName := "nexus_repository_raw_hosted.acceptance"
+	blobstoreName := fmt.Sprintf("test-blobstore-%s", acctest.RandString(5))
+	blobstoreConfig := fmt.Sprintf(`
+resource "nexus_blobstore_file" "acceptance" {
+	name = "%s"
+	path = "/nexus-data/%s"
+}
+`, blobstoreName, blobstoreName)
+
+	movedRepo := repo
+	// reference the blobstore, so the repository is destroyed first
+	movedRepo.Storage.BlobStoreName = "${nexus_blobstore_file.acceptance.name}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: blobstoreConfig + testAccResourceRepositoryRawHostedConfig(repo),
+				Check:  resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
+			},
+			{
+				Config: blobstoreConfig + testAccResourceRepositoryRawHostedConfig(movedRepo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", blobstoreName),
+				),
+			},
+		},
+	})
+}
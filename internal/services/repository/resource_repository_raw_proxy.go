@@ -13,11 +13,13 @@ func ResourceRepositoryRawProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a raw proxy repository.",
 
-		Create: resourceRawProxyRepositoryCreate,
-		Delete: resourceRawProxyRepositoryDelete,
-		Exists: resourceRawProxyRepositoryExists,
-		Read:   resourceRawProxyRepositoryRead,
-		Update: resourceRawProxyRepositoryUpdate,
+		Create:        resourceRawProxyRepositoryCreate,
+		Delete:        resourceRawProxyRepositoryDelete,
+		Exists:        resourceRawProxyRepositoryExists,
+		Read:          resourceRawProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRawProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -172,7 +174,7 @@ func resourceRawProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceRawProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Raw.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "raw", "proxy", resourceData.Id(), client.Repository.Raw.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -206,6 +208,6 @@ func resourceRawProxyRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceRawProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Raw.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "raw", "proxy", resourceData.Id(), client.Repository.Raw.Proxy.Get)
 	return repo != nil, err
 }
@@ -14,11 +14,13 @@ func ResourceRepositoryRubygemsGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group rubygems repository.",
 
-		Create: resourceRubygemsGroupRepositoryCreate,
-		Delete: resourceRubygemsGroupRepositoryDelete,
-		Exists: resourceRubygemsGroupRepositoryExists,
-		Read:   resourceRubygemsGroupRepositoryRead,
-		Update: resourceRubygemsGroupRepositoryUpdate,
+		Create:        resourceRubygemsGroupRepositoryCreate,
+		Delete:        resourceRubygemsGroupRepositoryDelete,
+		Exists:        resourceRubygemsGroupRepositoryExists,
+		Read:          resourceRubygemsGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRubygemsGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +93,7 @@ func resourceRubygemsGroupRepositoryCreate(resourceData *schema.ResourceData, m
 func resourceRubygemsGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.RubyGems.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "rubygems", "group", resourceData.Id(), client.Repository.RubyGems.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -131,6 +133,6 @@ func resourceRubygemsGroupRepositoryDelete(resourceData *schema.ResourceData, m
 func resourceRubygemsGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.RubyGems.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "rubygems", "group", resourceData.Id(), client.Repository.RubyGems.Group.Get)
 	return repo != nil, err
 }
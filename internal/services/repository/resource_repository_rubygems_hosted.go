@@ -13,11 +13,13 @@ func ResourceRepositoryRubygemsHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Rubygems repository.",
 
-		Create: resourceRubygemsHostedRepositoryCreate,
-		Delete: resourceRubygemsHostedRepositoryDelete,
-		Exists: resourceRubygemsHostedRepositoryExists,
-		Read:   resourceRubygemsHostedRepositoryRead,
-		Update: resourceRubygemsHostedRepositoryUpdate,
+		Create:        resourceRubygemsHostedRepositoryCreate,
+		Delete:        resourceRubygemsHostedRepositoryDelete,
+		Exists:        resourceRubygemsHostedRepositoryExists,
+		Read:          resourceRubygemsHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRubygemsHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -115,7 +117,7 @@ func resourceRubygemsHostedRepositoryCreate(resourceData *schema.ResourceData, m
 func resourceRubygemsHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.RubyGems.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "rubygems", "hosted", resourceData.Id(), client.Repository.RubyGems.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -149,6 +151,6 @@ func resourceRubygemsHostedRepositoryDelete(resourceData *schema.ResourceData, m
 func resourceRubygemsHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.RubyGems.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "rubygems", "hosted", resourceData.Id(), client.Repository.RubyGems.Hosted.Get)
 	return repo != nil, err
 }
@@ -13,11 +13,13 @@ func ResourceRepositoryRubygemsProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create an NPM proxy repository.",
 
-		Create: resourceRubygemsProxyRepositoryCreate,
-		Delete: resourceRubygemsProxyRepositoryDelete,
-		Exists: resourceRubygemsProxyRepositoryExists,
-		Read:   resourceRubygemsProxyRepositoryRead,
-		Update: resourceRubygemsProxyRepositoryUpdate,
+		Create:        resourceRubygemsProxyRepositoryCreate,
+		Delete:        resourceRubygemsProxyRepositoryDelete,
+		Exists:        resourceRubygemsProxyRepositoryExists,
+		Read:          resourceRubygemsProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceRubygemsProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -166,7 +168,7 @@ func resourceRubygemsProxyRepositoryCreate(resourceData *schema.ResourceData, m
 func resourceRubygemsProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.RubyGems.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "rubygems", "proxy", resourceData.Id(), client.Repository.RubyGems.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -200,6 +202,6 @@ func resourceRubygemsProxyRepositoryDelete(resourceData *schema.ResourceData, m
 func resourceRubygemsProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.RubyGems.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "rubygems", "proxy", resourceData.Id(), client.Repository.RubyGems.Proxy.Get)
 	return repo != nil, err
 }
@@ -15,11 +15,13 @@ func ResourceRepositoryYumGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a group yum repository.",
 
-		Create: resourceYumGroupRepositoryCreate,
-		Delete: resourceYumGroupRepositoryDelete,
-		Exists: resourceYumGroupRepositoryExists,
-		Read:   resourceYumGroupRepositoryRead,
-		Update: resourceYumGroupRepositoryUpdate,
+		Create:        resourceYumGroupRepositoryCreate,
+		Delete:        resourceYumGroupRepositoryDelete,
+		Exists:        resourceYumGroupRepositoryExists,
+		Read:          resourceYumGroupRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceYumGroupRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -106,7 +108,7 @@ func resourceYumGroupRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceYumGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Yum.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "yum", "group", resourceData.Id(), client.Repository.Yum.Group.Get)
 	if err != nil {
 		return err
 	}
@@ -146,6 +148,6 @@ func resourceYumGroupRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceYumGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Yum.Group.Get(resourceData.Id())
+	repo, err := getRepository(m, "yum", "group", resourceData.Id(), client.Repository.Yum.Group.Get)
 	return repo != nil, err
 }
@@ -14,11 +14,13 @@ func ResourceRepositoryYumHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted yum repository.",
 
-		Create: resourceYumHostedRepositoryCreate,
-		Delete: resourceYumHostedRepositoryDelete,
-		Exists: resourceYumHostedRepositoryExists,
-		Read:   resourceYumHostedRepositoryRead,
-		Update: resourceYumHostedRepositoryUpdate,
+		Create:        resourceYumHostedRepositoryCreate,
+		Delete:        resourceYumHostedRepositoryDelete,
+		Exists:        resourceYumHostedRepositoryExists,
+		Read:          resourceYumHostedRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceYumHostedRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -138,7 +140,7 @@ func resourceYumHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 func resourceYumHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Yum.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "yum", "hosted", resourceData.Id(), client.Repository.Yum.Hosted.Get)
 	if err != nil {
 		return err
 	}
@@ -172,6 +174,6 @@ func resourceYumHostedRepositoryDelete(resourceData *schema.ResourceData, m inte
 func resourceYumHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Yum.Hosted.Get(resourceData.Id())
+	repo, err := getRepository(m, "yum", "hosted", resourceData.Id(), client.Repository.Yum.Hosted.Get)
 	return repo != nil, err
 }
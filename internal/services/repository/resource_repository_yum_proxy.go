@@ -13,11 +13,13 @@ func ResourceRepositoryYumProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a yum proxy repository.",
 
-		Create: resourceYumProxyRepositoryCreate,
-		Delete: resourceYumProxyRepositoryDelete,
-		Exists: resourceYumProxyRepositoryExists,
-		Read:   resourceYumProxyRepositoryRead,
-		Update: resourceYumProxyRepositoryUpdate,
+		Create:        resourceYumProxyRepositoryCreate,
+		Delete:        resourceYumProxyRepositoryDelete,
+		Exists:        resourceYumProxyRepositoryExists,
+		Read:          resourceYumProxyRepositoryRead,
+		UpdateContext: updateRepositoryContext(resourceYumProxyRepositoryUpdate),
+		CustomizeDiff: resourceRepositoryCustomizeDiff,
+		Timeouts:      repositoryTimeouts,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -181,7 +183,7 @@ func resourceYumProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 func resourceYumProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Yum.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "yum", "proxy", resourceData.Id(), client.Repository.Yum.Proxy.Get)
 	if err != nil {
 		return err
 	}
@@ -215,6 +217,6 @@ func resourceYumProxyRepositoryDelete(resourceData *schema.ResourceData, m inter
 func resourceYumProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
 	client := m.(*nexus.NexusClient)
 
-	repo, err := client.Repository.Yum.Proxy.Get(resourceData.Id())
+	repo, err := getRepository(m, "yum", "proxy", resourceData.Id(), client.Repository.Yum.Proxy.Get)
 	return repo != nil, err
 }
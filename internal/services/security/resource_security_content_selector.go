@@ -1,9 +1,14 @@
 package security
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	nexus "github.com/nduyphuong/go-nexus-client/nexus3"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/security"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/csel"
 	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
 )
 
@@ -33,14 +38,54 @@ func ResourceSecurityContentSelector() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"expression": {
-				Description: "The content selector expression",
-				Required:    true,
-				Type:        schema.TypeString,
+				Description:      "The content selector expression, e.g. `format == \"maven2\" and path =^ \"/com/example/\"`. It is validated at plan time and may only compare the attributes `format`, `path` and `coordinate.<name>`. Comparisons which most likely never match, e.g. of paths without leading `/`, cause warnings. Changes of whitespace outside of strings are ignored",
+				DiffSuppressFunc: suppressContentSelectorExpressionDiff,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateContentSelectorExpression,
 			},
 		},
 	}
 }
 
+// validateContentSelectorExpression fails for invalid expressions and warns
+// about comparisons which are valid but most likely mistakes, e.g. paths
+// without leading "/", which nexus accepts but which never match
+func validateContentSelectorExpression(v interface{}, p cty.Path) diag.Diagnostics {
+	expression := v.(string)
+	if err := csel.Validate(expression); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("invalid expression: %v", err),
+			AttributePath: p,
+		}}
+	}
+
+	var diags diag.Diagnostics
+	for _, warning := range csel.Warnings(expression) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("suspicious expression: %v", warning),
+			AttributePath: p,
+		})
+	}
+	return diags
+}
+
+// suppressContentSelectorExpressionDiff ignores changes of the whitespace
+// between the tokens of an expression, nexus stores expressions as they are
+func suppressContentSelectorExpressionDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldExpression, err := csel.Normalize(old)
+	if err != nil {
+		return false
+	}
+	newExpression, err := csel.Normalize(new)
+	if err != nil {
+		return false
+	}
+	return oldExpression == newExpression
+}
+
 func getContentSelectorFromResourceData(d *schema.ResourceData) security.ContentSelector {
 	contentSelector := security.ContentSelector{
 		Name:       d.Get("name").(string),
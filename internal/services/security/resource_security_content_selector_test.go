@@ -2,6 +2,8 @@ package security_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -36,6 +38,15 @@ func TestAccResourceSecurityContentSelector(t *testing.T) {
 					testAccCheckContentSelectorResourceExists(resName, &contentSelector),
 				),
 			},
+			// Changes of whitespace between the tokens are ignored
+			{
+				Config: testAccResourceSecurityContentSelectorConfig(security.ContentSelector{
+					Name:        cs.Name,
+					Description: cs.Description,
+					Expression:  strings.ReplaceAll(strings.ReplaceAll(cs.Expression, " == ", "=="), " and ", "  and "),
+				}),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resName,
 				ImportStateId:     contentSelector.Name,
@@ -46,6 +57,46 @@ func TestAccResourceSecurityContentSelector(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityContentSelectorInvalidExpression(t *testing.T) {
+	cs := security.ContentSelector{
+		Name:       acctest.RandString(10),
+		Expression: "format == 'maven2' and version == '1.0'",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecurityContentSelectorConfig(cs),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unknown attribute "version", supported are format, path and\s+coordinate.<name> at column 24`),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityContentSelectorSuspiciousExpression(t *testing.T) {
+	// paths without leading "/" never match, but nexus accepts them, so
+	// they only cause a warning
+	cs := security.ContentSelector{
+		Name:       acctest.RandString(10),
+		Expression: "format == 'raw' and path =^ 'com/example/'",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccResourceSecurityContentSelectorConfig(cs),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccResourceSecurityContentSelectorConfig(cs security.ContentSelector) string {
 	return fmt.Sprintf(`
 resource "nexus_security_content_selector" "acceptance" {
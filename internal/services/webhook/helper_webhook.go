@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/api"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+// Webhooks are capabilities of the types below. The event types are stored
+// comma separated in the "names" property of the capability.
+const (
+	capabilityTypeWebhookGlobal     = "webhook.global"
+	capabilityTypeWebhookRepository = "webhook.repository"
+)
+
+func resourceWebhookEventTypes(eventTypes []string) *schema.Schema {
+	return &schema.Schema{
+		Description: "The event types which trigger the webhook, possible values: `" + strings.Join(eventTypes, "`, `") + "`",
+		Required:    true,
+		MinItems:    1,
+		Type:        schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(eventTypes, false),
+		},
+	}
+}
+
+var (
+	resourceWebhookURL = &schema.Schema{
+		Description:  "The URL the events are sent to",
+		Required:     true,
+		Type:         schema.TypeString,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+	}
+	resourceWebhookSecret = &schema.Schema{
+		Description: "The key used to sign the events with HMAC-SHA1. The signature is sent in the `X-Nexus-Webhook-Signature` header",
+		Optional:    true,
+		Sensitive:   true,
+		Type:        schema.TypeString,
+	}
+)
+
+func getWebhookProperties(resourceData *schema.ResourceData) map[string]string {
+	eventTypes := tools.ConvertStringSet(resourceData.Get("event_types").(*schema.Set))
+	sort.Strings(eventTypes)
+
+	properties := map[string]string{
+		"names": strings.Join(eventTypes, ","),
+		"url":   resourceData.Get("url").(string),
+	}
+	if secret := resourceData.Get("secret").(string); secret != "" {
+		properties["secret"] = secret
+	}
+	return properties
+}
+
+// setWebhookToResourceData sets the attributes shared by all webhooks. The
+// secret is never read back, as nexus only returns it encrypted.
+func setWebhookToResourceData(capability *api.Capability, resourceData *schema.ResourceData) error {
+	resourceData.SetId(capability.ID)
+	resourceData.Set("enabled", capability.Enabled)
+	resourceData.Set("notes", capability.Notes)
+	resourceData.Set("url", capability.Properties["url"])
+
+	var eventTypes []string
+	for _, eventType := range strings.Split(capability.Properties["names"], ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	return resourceData.Set("event_types", tools.StringSliceToInterfaceSlice(eventTypes))
+}
+
+func readWebhook(resourceData *schema.ResourceData, m interface{}) (*api.Capability, error) {
+	client := api.FromMeta(m)
+
+	capability, err := client.Capability.Get(resourceData.Id())
+	if errors.Is(err, api.ErrScriptNotStored) {
+		log.Printf("[WARN] Could not refresh webhook %s: %v", resourceData.Id(), err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if capability == nil {
+		resourceData.SetId("")
+		return nil, nil
+	}
+
+	return capability, setWebhookToResourceData(capability, resourceData)
+}
+
+func createWebhook(resourceData *schema.ResourceData, m interface{}, typeID string, properties map[string]string) error {
+	client := api.FromMeta(m)
+
+	created, err := client.Capability.Create(&api.Capability{
+		TypeID:     typeID,
+		Enabled:    resourceData.Get("enabled").(bool),
+		Notes:      resourceData.Get("notes").(string),
+		Properties: properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	resourceData.SetId(created.ID)
+	return nil
+}
+
+func updateWebhook(resourceData *schema.ResourceData, m interface{}, typeID string, properties map[string]string) error {
+	client := api.FromMeta(m)
+
+	_, err := client.Capability.Update(&api.Capability{
+		ID:         resourceData.Id(),
+		TypeID:     typeID,
+		Enabled:    resourceData.Get("enabled").(bool),
+		Notes:      resourceData.Get("notes").(string),
+		Properties: properties,
+	})
+	return err
+}
+
+func deleteWebhook(resourceData *schema.ResourceData, m interface{}) error {
+	client := api.FromMeta(m)
+
+	if err := client.Capability.Delete(resourceData.Id()); err != nil {
+		return err
+	}
+
+	resourceData.SetId("")
+	return nil
+}
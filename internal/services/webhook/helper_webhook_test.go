@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWebhookProperties(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceWebhookRepository().Schema, map[string]interface{}{
+		"repository":  "maven-releases",
+		"event_types": []interface{}{"component", "asset"},
+		"url":         "https://example.com/webhook",
+		"secret":      "s3cr3t",
+	})
+
+	assert.Equal(t, map[string]string{
+		"names":      "asset,component",
+		"url":        "https://example.com/webhook",
+		"secret":     "s3cr3t",
+		"repository": "maven-releases",
+	}, getWebhookRepositoryProperties(resourceData))
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceWebhookGlobal().Schema, map[string]interface{}{
+		"event_types": []interface{}{"audit"},
+		"url":         "https://example.com/webhook",
+	})
+
+	assert.Equal(t, map[string]string{
+		"names": "audit",
+		"url":   "https://example.com/webhook",
+	}, getWebhookProperties(resourceData))
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	capabilitySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceWebhookGlobal() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to create a global webhook, which sends an HTTP POST request for repository and audit events of the nexus repository manager.
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create: resourceWebhookGlobalCreate,
+		Read:   resourceWebhookGlobalRead,
+		Update: resourceWebhookGlobalUpdate,
+		Delete: deleteWebhook,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id":          common.ResourceID,
+			"enabled":     capabilitySchema.ResourceEnabled,
+			"notes":       capabilitySchema.ResourceNotes,
+			"event_types": resourceWebhookEventTypes([]string{"audit", "repository"}),
+			"url":         resourceWebhookURL,
+			"secret":      resourceWebhookSecret,
+		},
+	}
+}
+
+func resourceWebhookGlobalCreate(resourceData *schema.ResourceData, m interface{}) error {
+	if err := createWebhook(resourceData, m, capabilityTypeWebhookGlobal, getWebhookProperties(resourceData)); err != nil {
+		return err
+	}
+
+	return resourceWebhookGlobalRead(resourceData, m)
+}
+
+func resourceWebhookGlobalRead(resourceData *schema.ResourceData, m interface{}) error {
+	_, err := readWebhook(resourceData, m)
+	return err
+}
+
+func resourceWebhookGlobalUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	if err := updateWebhook(resourceData, m, capabilityTypeWebhookGlobal, getWebhookProperties(resourceData)); err != nil {
+		return err
+	}
+
+	return resourceWebhookGlobalRead(resourceData, m)
+}
@@ -0,0 +1,96 @@
+package webhook_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccResourceWebhookGlobal(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resName := "nexus_webhook_global.acceptance"
+	secret := acctest.RandString(20)
+	repoName := fmt.Sprintf("acceptance-webhook-%s", acctest.RandString(10))
+
+	receiver := acceptance.NewWebhookReceiver(t, secret)
+	url := receiver.URL(tools.GetEnv("WEBHOOK_RECEIVER_HOST", "host.docker.internal"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWebhookGlobalConfig(url, secret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "url", url),
+					resource.TestCheckResourceAttr(resName, "secret", secret),
+					resource.TestCheckResourceAttr(resName, "event_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "event_types.*", "repository"),
+				),
+			},
+			{
+				// Creating a repository triggers a repository event, but no
+				// audit event must be sent as it is not selected
+				Config: testAccResourceWebhookGlobalConfig(url, secret) + testAccResourceWebhookGlobalRepositoryConfig(repoName),
+				Check: func(*terraform.State) error {
+					delivery, err := receiver.WaitFor("rm:repository:repository", 30*time.Second)
+					if err != nil {
+						return err
+					}
+					if !delivery.ValidSignature {
+						return fmt.Errorf("webhook delivery has an invalid HMAC signature")
+					}
+					for _, delivery := range receiver.Deliveries() {
+						if delivery.EventID == "rm:global:audit" {
+							return fmt.Errorf("received audit event although only repository events are selected")
+						}
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccResourceWebhookGlobalConfig(url string, secret string) string {
+	return fmt.Sprintf(`
+resource "nexus_webhook_global" "acceptance" {
+	event_types = ["repository"]
+	url         = "%s"
+	secret      = "%s"
+}
+`, url, secret)
+}
+
+func testAccResourceWebhookGlobalRepositoryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	depends_on = [nexus_webhook_global.acceptance]
+
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+`, name)
+}
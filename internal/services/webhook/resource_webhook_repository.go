@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	capabilitySchema "github.com/nduyphuong/terraform-provider-nexus/internal/schema/capability"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/schema/common"
+)
+
+func ResourceWebhookRepository() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to create a repository webhook, which sends an HTTP POST request for component and asset events of a single repository.
+
+~> This resource is implemented with a groovy script, so scripting must be enabled in nexus`,
+
+		Create: resourceWebhookRepositoryCreate,
+		Read:   resourceWebhookRepositoryRead,
+		Update: resourceWebhookRepositoryUpdate,
+		Delete: deleteWebhook,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id":      common.ResourceID,
+			"enabled": capabilitySchema.ResourceEnabled,
+			"notes":   capabilitySchema.ResourceNotes,
+			"repository": {
+				Description: "The name of the repository whose events trigger the webhook",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"event_types": resourceWebhookEventTypes([]string{"asset", "component"}),
+			"url":         resourceWebhookURL,
+			"secret":      resourceWebhookSecret,
+		},
+	}
+}
+
+func getWebhookRepositoryProperties(resourceData *schema.ResourceData) map[string]string {
+	properties := getWebhookProperties(resourceData)
+	properties["repository"] = resourceData.Get("repository").(string)
+	return properties
+}
+
+func resourceWebhookRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	if err := createWebhook(resourceData, m, capabilityTypeWebhookRepository, getWebhookRepositoryProperties(resourceData)); err != nil {
+		return err
+	}
+
+	return resourceWebhookRepositoryRead(resourceData, m)
+}
+
+func resourceWebhookRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	capability, err := readWebhook(resourceData, m)
+	if err != nil || capability == nil {
+		return err
+	}
+
+	resourceData.Set("repository", capability.Properties["repository"])
+	return nil
+}
+
+func resourceWebhookRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	if err := updateWebhook(resourceData, m, capabilityTypeWebhookRepository, getWebhookRepositoryProperties(resourceData)); err != nil {
+		return err
+	}
+
+	return resourceWebhookRepositoryRead(resourceData, m)
+}
@@ -0,0 +1,73 @@
+package webhook_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/acceptance"
+	"github.com/nduyphuong/terraform-provider-nexus/internal/tools"
+)
+
+func TestAccResourceWebhookRepository(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resName := "nexus_webhook_repository.acceptance"
+	repoName := fmt.Sprintf("acceptance-webhook-%s", acctest.RandString(10))
+	url := "https://example.com/webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWebhookRepositoryConfig(repoName, url, `["component"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "repository", repoName),
+					resource.TestCheckResourceAttr(resName, "url", url),
+					resource.TestCheckResourceAttr(resName, "event_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "event_types.*", "component"),
+				),
+			},
+			{
+				Config: testAccResourceWebhookRepositoryConfig(repoName, url, `["asset", "component"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "event_types.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resName, "event_types.*", "asset"),
+					resource.TestCheckTypeSetElemAttr(resName, "event_types.*", "component"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccResourceWebhookRepositoryConfig(repoName string, url string, eventTypes string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name = "%s"
+
+	storage {
+		blob_store_name                = "default"
+		strict_content_type_validation = true
+		write_policy                   = "ALLOW"
+	}
+}
+
+resource "nexus_webhook_repository" "acceptance" {
+	repository  = nexus_repository_raw_hosted.acceptance.name
+	event_types = %s
+	url         = "%s"
+	secret      = "acceptance"
+}
+`, repoName, eventTypes, url)
+}